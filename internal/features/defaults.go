@@ -7,6 +7,10 @@ func Default() UserFeatures {
 			PurgeSoftDeleteOnDestroy: true,
 			RecoverSoftDeleted:       true,
 		},
+		AppConfiguration: AppConfigurationFeatures{
+			PurgeSoftDeleteOnDestroy: true,
+			RecoverSoftDeleted:       true,
+		},
 		ApplicationInsights: ApplicationInsightFeatures{
 			DisableGeneratedRule: false,
 		},
@@ -26,8 +30,18 @@ func Default() UserFeatures {
 		LogAnalyticsWorkspace: LogAnalyticsWorkspaceFeatures{
 			PermanentlyDeleteOnDestroy: true,
 		},
+		MSSQLDatabase: MSSQLDatabaseFeatures{
+			RecoverDroppedDatabase: false,
+		},
+		RecoveryServicesVault: RecoveryServicesVaultFeatures{
+			RecoverSoftDeletedBackupProtectedVM: true,
+		},
 		ResourceGroup: ResourceGroupFeatures{
 			PreventDeletionIfContainsResources: true,
+			WaitForDeletingResources:           false,
+		},
+		Storage: StorageFeatures{
+			DataPlaneAvailable: true,
 		},
 		TemplateDeployment: TemplateDeploymentFeatures{
 			DeleteNestedItemsDuringDeletion: true,
@@ -2,6 +2,7 @@ package features
 
 type UserFeatures struct {
 	ApiManagement          ApiManagementFeatures
+	AppConfiguration       AppConfigurationFeatures
 	ApplicationInsights    ApplicationInsightFeatures
 	CognitiveAccount       CognitiveAccountFeatures
 	VirtualMachine         VirtualMachineFeatures
@@ -9,7 +10,10 @@ type UserFeatures struct {
 	KeyVault               KeyVaultFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
+	MSSQLDatabase          MSSQLDatabaseFeatures
+	RecoveryServicesVault  RecoveryServicesVaultFeatures
 	ResourceGroup          ResourceGroupFeatures
+	Storage                StorageFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -47,8 +51,13 @@ type LogAnalyticsWorkspaceFeatures struct {
 	PermanentlyDeleteOnDestroy bool
 }
 
+type MSSQLDatabaseFeatures struct {
+	RecoverDroppedDatabase bool
+}
+
 type ResourceGroupFeatures struct {
 	PreventDeletionIfContainsResources bool
+	WaitForDeletingResources           bool
 }
 
 type ApiManagementFeatures struct {
@@ -56,6 +65,19 @@ type ApiManagementFeatures struct {
 	RecoverSoftDeleted       bool
 }
 
+type AppConfigurationFeatures struct {
+	PurgeSoftDeleteOnDestroy bool
+	RecoverSoftDeleted       bool
+}
+
 type ApplicationInsightFeatures struct {
 	DisableGeneratedRule bool
 }
+
+type StorageFeatures struct {
+	DataPlaneAvailable bool
+}
+
+type RecoveryServicesVaultFeatures struct {
+	RecoverSoftDeletedBackupProtectedVM bool
+}
@@ -22,6 +22,10 @@ func TestExpandFeatures(t *testing.T) {
 					PurgeSoftDeleteOnDestroy: true,
 					RecoverSoftDeleted:       true,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
 				ApplicationInsights: features.ApplicationInsightFeatures{
 					DisableGeneratedRule: false,
 				},
@@ -54,9 +58,15 @@ func TestExpandFeatures(t *testing.T) {
 					RollInstancesWhenRequired: true,
 					ScaleToZeroOnDelete:       true,
 				},
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					RecoverSoftDeletedBackupProtectedVM: true,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
 			},
 		},
 		{
@@ -69,6 +79,12 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted":         true,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
+						},
+					},
 					"application_insights": []interface{}{
 						map[string]interface{}{
 							"disable_generated_rule": true,
@@ -96,16 +112,31 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": true,
 						},
 					},
+					"mssql_database": []interface{}{
+						map[string]interface{}{
+							"recover_dropped_database": true,
+						},
+					},
 					"network": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": true,
 						},
 					},
+					"recovery_services_vaults": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_backup_protected_vm": true,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": true,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": true,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": true,
@@ -132,6 +163,10 @@ func TestExpandFeatures(t *testing.T) {
 					PurgeSoftDeleteOnDestroy: true,
 					RecoverSoftDeleted:       true,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
 				ApplicationInsights: features.ApplicationInsightFeatures{
 					DisableGeneratedRule: true,
 				},
@@ -151,9 +186,18 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
 				},
+				MSSQLDatabase: features.MSSQLDatabaseFeatures{
+					RecoverDroppedDatabase: true,
+				},
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					RecoverSoftDeletedBackupProtectedVM: true,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -179,6 +223,12 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted":         false,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
+						},
+					},
 					"application_insights": []interface{}{
 						map[string]interface{}{
 							"disable_generated_rule": false,
@@ -206,16 +256,31 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": false,
 						},
 					},
+					"mssql_database": []interface{}{
+						map[string]interface{}{
+							"recover_dropped_database": false,
+						},
+					},
 					"network_locking": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": false,
 						},
 					},
+					"recovery_services_vaults": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_backup_protected_vm": false,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": false,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": false,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": false,
@@ -242,6 +307,10 @@ func TestExpandFeatures(t *testing.T) {
 					PurgeSoftDeleteOnDestroy: false,
 					RecoverSoftDeleted:       false,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
+				},
 				ApplicationInsights: features.ApplicationInsightFeatures{
 					DisableGeneratedRule: false,
 				},
@@ -261,9 +330,18 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				MSSQLDatabase: features.MSSQLDatabaseFeatures{
+					RecoverDroppedDatabase: false,
+				},
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					RecoverSoftDeletedBackupProtectedVM: false,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: false,
 				},
@@ -360,6 +438,76 @@ func TestExpandFeaturesApiManagement(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesAppConfiguration(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
+			},
+		},
+		{
+			Name: "Purge Soft Delete and Recover Soft Deleted Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
+			},
+		},
+		{
+			Name: "Purge Soft Delete and Recover Soft Deleted Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.AppConfiguration, testCase.Expected.AppConfiguration) {
+			t.Fatalf("Expected %+v but got %+v", result.AppConfiguration, testCase.Expected.AppConfiguration)
+		}
+	}
+}
+
 func TestExpandFeaturesApplicationInsights(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -951,6 +1099,135 @@ func TestExpandFeaturesLogAnalyticsWorkspace(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesMSSQLDatabase(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"mssql_database": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				MSSQLDatabase: features.MSSQLDatabaseFeatures{
+					RecoverDroppedDatabase: false,
+				},
+			},
+		},
+		{
+			Name: "Recover Dropped Database Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"mssql_database": []interface{}{
+						map[string]interface{}{
+							"recover_dropped_database": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				MSSQLDatabase: features.MSSQLDatabaseFeatures{
+					RecoverDroppedDatabase: true,
+				},
+			},
+		},
+		{
+			Name: "Recover Dropped Database Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"mssql_database": []interface{}{
+						map[string]interface{}{
+							"recover_dropped_database": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				MSSQLDatabase: features.MSSQLDatabaseFeatures{
+					RecoverDroppedDatabase: false,
+				},
+			},
+		},
+	}
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.MSSQLDatabase, testCase.Expected.MSSQLDatabase) {
+			t.Fatalf("Expected %+v but got %+v", result.MSSQLDatabase, testCase.Expected.MSSQLDatabase)
+		}
+	}
+}
+
+func TestExpandFeaturesRecoveryServicesVault(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"recovery_services_vaults": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					RecoverSoftDeletedBackupProtectedVM: true,
+				},
+			},
+		},
+		{
+			Name: "Recover Soft Deleted Backup Protected VM Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"recovery_services_vaults": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_backup_protected_vm": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					RecoverSoftDeletedBackupProtectedVM: true,
+				},
+			},
+		},
+		{
+			Name: "Recover Soft Deleted Backup Protected VM Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"recovery_services_vaults": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_backup_protected_vm": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					RecoverSoftDeletedBackupProtectedVM: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.RecoveryServicesVault, testCase.Expected.RecoveryServicesVault) {
+			t.Fatalf("Expected %+v but got %+v", result.RecoveryServicesVault, testCase.Expected.RecoveryServicesVault)
+		}
+	}
+}
+
 func TestExpandFeaturesResourceGroup(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -988,6 +1265,25 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Wait For Deleting Resources Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": true,
+							"wait_for_deleting_resources":            true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: true,
+					WaitForDeletingResources:           true,
+				},
+			},
+		},
 		{
 			Name: "Prevent Deletion If Contains Resources Disabled",
 			Input: []interface{}{
@@ -1005,6 +1301,25 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Wait For Deleting Resources Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": true,
+							"wait_for_deleting_resources":            false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: true,
+					WaitForDeletingResources:           false,
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testData {
@@ -1015,3 +1330,68 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesStorage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
+			},
+		},
+		{
+			Name: "Data Plane Available Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: true,
+				},
+			},
+		},
+		{
+			Name: "Data Plane Available Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Storage, testCase.Expected.Storage) {
+			t.Fatalf("Expected %+v but got %+v", result.Storage, testCase.Expected.Storage)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package appconfiguration
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -16,7 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	legacyIdentity "github.com/hashicorp/terraform-provider-azurerm/internal/identity"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2022-05-01/configurationstores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -193,12 +194,40 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_app_configuration", resourceId.ID())
 	}
 
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// before creating check to see if the resource exists in the soft delete state
+	deletedId := configurationstores.NewDeletedConfigurationStoreID(subscriptionId, location, name)
+	softDeleted, err := client.GetDeleted(ctx, deletedId)
+	// If Terraform lacks permission to read at the Subscription we'll get 403, not 404
+	softDeleteNotFound := response.WasNotFound(softDeleted.HttpResponse) || (softDeleted.HttpResponse != nil && softDeleted.HttpResponse.StatusCode == http.StatusForbidden)
+	if err != nil && !softDeleteNotFound {
+		return fmt.Errorf("checking for the presence of an existing %s: %+v", deletedId, err)
+	}
+
+	recoverSoftDeleted := false
+	if !softDeleteNotFound {
+		if !meta.(*clients.Client).Features.AppConfiguration.RecoverSoftDeleted {
+			// this exists but the users opted out, so they must import this it out-of-band
+			return fmt.Errorf(optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location))
+		}
+
+		recoverSoftDeleted = true
+	}
+
 	parameters := configurationstores.ConfigurationStore{
-		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Location: location,
 		Sku: configurationstores.Sku{
 			Name: d.Get("sku").(string),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Properties: &configurationstores.ConfigurationStoreProperties{},
+		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if recoverSoftDeleted {
+		log.Printf("[DEBUG] Recovering the Soft-Deleted %s", deletedId)
+		createMode := configurationstores.CreateModeRecover
+		parameters.Properties.CreateMode = &createMode
 	}
 
 	identity, err := expandAppConfigurationIdentity(d.Get("identity").([]interface{}))
@@ -314,13 +343,67 @@ func resourceAppConfigurationDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
+	// Purge the soft deleted App Configuration permanently if the feature flag is enabled
+	if meta.(*clients.Client).Features.AppConfiguration.PurgeSoftDeleteOnDestroy {
+		deletedId := configurationstores.NewDeletedConfigurationStoreID(id.SubscriptionId, azure.NormalizeLocation(existing.Model.Location), id.ConfigStoreName)
+
+		// the Free SKU doesn't support soft delete, in which case there's nothing to purge
+		softDeleted, err := client.GetDeleted(ctx, deletedId)
+		if err != nil {
+			if response.WasNotFound(softDeleted.HttpResponse) {
+				return nil
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", deletedId, err)
+		}
+
+		if softDeleted.Model != nil && softDeleted.Model.Properties != nil && softDeleted.Model.Properties.PurgeProtectionEnabled != nil && *softDeleted.Model.Properties.PurgeProtectionEnabled {
+			log.Printf("[DEBUG] Purge Protection is enabled for %s - skipping purge", deletedId)
+			return nil
+		}
+
+		log.Printf("[DEBUG] %s marked for purge - executing purge", deletedId)
+		if err := client.PurgeDeletedThenPoll(ctx, deletedId); err != nil {
+			return fmt.Errorf("purging %s: %+v", deletedId, err)
+		}
+		log.Printf("[DEBUG] Purged %s.", deletedId)
+	}
+
 	return nil
 }
 
+func optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location string) string {
+	message := `
+An existing soft-deleted App Configuration exists with the Name %q in the location %q, however
+automatically recovering this App Configuration has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted App Configuration when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different name/location.
+`
+	return fmt.Sprintf(message, name, location)
+}
+
 type flattenedAccessKeys struct {
 	primaryReadKey    []interface{}
 	primaryWriteKey   []interface{}
@@ -68,6 +68,36 @@ func parseConnectionStatus(input string) (*ConnectionStatus, error) {
 	return &out, nil
 }
 
+type CreateMode string
+
+const (
+	CreateModeDefault CreateMode = "Default"
+	CreateModeRecover CreateMode = "Recover"
+)
+
+func PossibleValuesForCreateMode() []string {
+	return []string{
+		"Default",
+		"Recover",
+	}
+}
+
+func parseCreateMode(input string) (*CreateMode, error) {
+	vals := map[string]CreateMode{
+		"default": "Default",
+		"recover": "Recover",
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// it could be a new value - best effort convert this
+	v := input
+
+	out := CreateMode(v)
+	return &out, nil
+}
+
 type ProvisioningState string
 
 const (
@@ -2,7 +2,7 @@ package configurationstores
 
 import "fmt"
 
-const defaultApiVersion = "2020-06-01"
+const defaultApiVersion = "2022-05-01"
 
 func userAgent() string {
 	return fmt.Sprintf("pandora/configurationstores/%s", defaultApiVersion)
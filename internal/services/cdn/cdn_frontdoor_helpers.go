@@ -0,0 +1,69 @@
+package cdn
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func expandFrontDoorEnabledBool(input bool) cdn.EnabledState {
+	if input {
+		return cdn.EnabledStateEnabled
+	}
+
+	return cdn.EnabledStateDisabled
+}
+
+func flattenFrontDoorEnabledBool(input cdn.EnabledState) bool {
+	return input == cdn.EnabledStateEnabled
+}
+
+func expandFrontDoorResourceReference(input string) *cdn.ResourceReference {
+	if input == "" {
+		return nil
+	}
+
+	return &cdn.ResourceReference{
+		ID: utils.String(input),
+	}
+}
+
+func flattenFrontDoorResourceReference(input *cdn.ResourceReference) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	return *input.ID
+}
+
+func expandFrontDoorResourceReferences(input []interface{}) *[]cdn.ResourceReference {
+	results := make([]cdn.ResourceReference, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		results = append(results, cdn.ResourceReference{
+			ID: utils.String(item.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenFrontDoorResourceReferences(input *[]cdn.ResourceReference) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID == nil {
+			continue
+		}
+
+		results = append(results, *item.ID)
+	}
+
+	return results
+}
@@ -0,0 +1,411 @@
+package cdn
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorRoute() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorRouteCreate,
+		Read:   resourceCdnFrontDoorRouteRead,
+		Update: resourceCdnFrontDoorRouteUpdate,
+		Delete: resourceCdnFrontDoorRouteDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorRouteID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cdn_frontdoor_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorEndpointID,
+			},
+
+			"cdn_frontdoor_origin_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.FrontDoorOriginGroupID,
+			},
+
+			"patterns_to_match": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 25,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"supported_protocols": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MaxItems: 2,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(cdn.AFDEndpointProtocolsHTTP),
+						string(cdn.AFDEndpointProtocolsHTTPS),
+					}, false),
+				},
+			},
+
+			"cdn_frontdoor_custom_domain_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorCustomDomainID,
+				},
+			},
+
+			"cdn_frontdoor_origin_path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cdn_frontdoor_rule_set_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorRuleSetID,
+				},
+			},
+
+			"compression_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"content_types_to_compress": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"forwarding_protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cdn.ForwardingProtocolMatchRequest),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.ForwardingProtocolHTTPOnly),
+					string(cdn.ForwardingProtocolHTTPSOnly),
+					string(cdn.ForwardingProtocolMatchRequest),
+				}, false),
+			},
+
+			"https_redirect_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"link_to_default_domain": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"query_string_caching_behavior": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+					string(cdn.AfdQueryStringCachingBehaviorUseQueryString),
+					string(cdn.AfdQueryStringCachingBehaviorNotSet),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorRouteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	endpointId, err := parse.FrontDoorEndpointID(d.Get("cdn_frontdoor_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorRouteID(endpointId.SubscriptionId, endpointId.ResourceGroup, endpointId.ProfileName, endpointId.AfdEndpointName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_route", id.ID())
+	}
+
+	props := cdn.Route{
+		RouteProperties: &cdn.RouteProperties{
+			CompressionSettings:        expandFrontDoorRouteCompressionSettings(d),
+			CustomDomains:              expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_custom_domain_ids").([]interface{})),
+			EnabledState:               expandFrontDoorEnabledBool(d.Get("enabled").(bool)),
+			ForwardingProtocol:         cdn.ForwardingProtocol(d.Get("forwarding_protocol").(string)),
+			HTTPSRedirect:              expandFrontDoorRouteHttpsRedirect(d.Get("https_redirect_enabled").(bool)),
+			LinkToDefaultDomain:        expandFrontDoorRouteLinkToDefaultDomain(d.Get("link_to_default_domain").(bool)),
+			OriginGroup:                expandFrontDoorResourceReference(d.Get("cdn_frontdoor_origin_group_id").(string)),
+			PatternsToMatch:            utils.ExpandStringSlice(d.Get("patterns_to_match").([]interface{})),
+			QueryStringCachingBehavior: cdn.AfdQueryStringCachingBehavior(d.Get("query_string_caching_behavior").(string)),
+			RuleSets:                   expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_rule_set_ids").([]interface{})),
+			SupportedProtocols:         expandFrontDoorRouteSupportedProtocols(d.Get("supported_protocols").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if v := d.Get("cdn_frontdoor_origin_path").(string); v != "" {
+		props.RouteProperties.OriginPath = utils.String(v)
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RouteName)
+	d.Set("cdn_frontdoor_endpoint_id", parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName).ID())
+
+	if props := resp.RouteProperties; props != nil {
+		d.Set("cdn_frontdoor_origin_group_id", flattenFrontDoorResourceReference(props.OriginGroup))
+		d.Set("cdn_frontdoor_origin_path", props.OriginPath)
+		d.Set("enabled", flattenFrontDoorEnabledBool(props.EnabledState))
+		d.Set("forwarding_protocol", string(props.ForwardingProtocol))
+		d.Set("https_redirect_enabled", props.HTTPSRedirect == cdn.HTTPSRedirectEnabled)
+		d.Set("link_to_default_domain", props.LinkToDefaultDomain == cdn.LinkToDefaultDomainEnabled)
+		d.Set("query_string_caching_behavior", string(props.QueryStringCachingBehavior))
+
+		if err := d.Set("cdn_frontdoor_custom_domain_ids", flattenFrontDoorResourceReferences(props.CustomDomains)); err != nil {
+			return fmt.Errorf("setting `cdn_frontdoor_custom_domain_ids`: %+v", err)
+		}
+
+		if err := d.Set("cdn_frontdoor_rule_set_ids", flattenFrontDoorResourceReferences(props.RuleSets)); err != nil {
+			return fmt.Errorf("setting `cdn_frontdoor_rule_set_ids`: %+v", err)
+		}
+
+		if err := d.Set("patterns_to_match", utils.FlattenStringSlice(props.PatternsToMatch)); err != nil {
+			return fmt.Errorf("setting `patterns_to_match`: %+v", err)
+		}
+
+		if err := d.Set("supported_protocols", flattenFrontDoorRouteSupportedProtocols(props.SupportedProtocols)); err != nil {
+			return fmt.Errorf("setting `supported_protocols`: %+v", err)
+		}
+
+		compressionEnabled, contentTypes, err := flattenFrontDoorRouteCompressionSettings(props.CompressionSettings)
+		if err != nil {
+			return fmt.Errorf("flattening `compression_settings`: %+v", err)
+		}
+		d.Set("compression_enabled", compressionEnabled)
+		if err := d.Set("content_types_to_compress", contentTypes); err != nil {
+			return fmt.Errorf("setting `content_types_to_compress`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorRouteUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := cdn.RouteUpdateParameters{
+		RouteUpdatePropertiesParameters: &cdn.RouteUpdatePropertiesParameters{
+			CompressionSettings:        expandFrontDoorRouteCompressionSettings(d),
+			CustomDomains:              expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_custom_domain_ids").([]interface{})),
+			EnabledState:               expandFrontDoorEnabledBool(d.Get("enabled").(bool)),
+			ForwardingProtocol:         cdn.ForwardingProtocol(d.Get("forwarding_protocol").(string)),
+			HTTPSRedirect:              expandFrontDoorRouteHttpsRedirect(d.Get("https_redirect_enabled").(bool)),
+			LinkToDefaultDomain:        expandFrontDoorRouteLinkToDefaultDomain(d.Get("link_to_default_domain").(bool)),
+			OriginGroup:                expandFrontDoorResourceReference(d.Get("cdn_frontdoor_origin_group_id").(string)),
+			PatternsToMatch:            utils.ExpandStringSlice(d.Get("patterns_to_match").([]interface{})),
+			QueryStringCachingBehavior: cdn.AfdQueryStringCachingBehavior(d.Get("query_string_caching_behavior").(string)),
+			RuleSets:                   expandFrontDoorResourceReferences(d.Get("cdn_frontdoor_rule_set_ids").([]interface{})),
+			SupportedProtocols:         expandFrontDoorRouteSupportedProtocols(d.Get("supported_protocols").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if v := d.Get("cdn_frontdoor_origin_path").(string); v != "" {
+		props.RouteUpdatePropertiesParameters.OriginPath = utils.String(v)
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, props)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFrontDoorRouteCompressionSettings(d *pluginsdk.ResourceData) interface{} {
+	return cdn.CompressionSettings{
+		ContentTypesToCompress: utils.ExpandStringSlice(d.Get("content_types_to_compress").([]interface{})),
+		IsCompressionEnabled:   utils.Bool(d.Get("compression_enabled").(bool)),
+	}
+}
+
+func flattenFrontDoorRouteCompressionSettings(input interface{}) (bool, []interface{}, error) {
+	if input == nil {
+		return false, []interface{}{}, nil
+	}
+
+	// the SDK exposes this as an `interface{}` so we need to round-trip it to get the typed model
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var settings cdn.CompressionSettings
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return false, nil, err
+	}
+
+	enabled := false
+	if settings.IsCompressionEnabled != nil {
+		enabled = *settings.IsCompressionEnabled
+	}
+
+	return enabled, utils.FlattenStringSlice(settings.ContentTypesToCompress), nil
+}
+
+func expandFrontDoorRouteHttpsRedirect(input bool) cdn.HTTPSRedirect {
+	if input {
+		return cdn.HTTPSRedirectEnabled
+	}
+
+	return cdn.HTTPSRedirectDisabled
+}
+
+func expandFrontDoorRouteLinkToDefaultDomain(input bool) cdn.LinkToDefaultDomain {
+	if input {
+		return cdn.LinkToDefaultDomainEnabled
+	}
+
+	return cdn.LinkToDefaultDomainDisabled
+}
+
+func expandFrontDoorRouteSupportedProtocols(input []interface{}) *[]cdn.AFDEndpointProtocols {
+	results := make([]cdn.AFDEndpointProtocols, 0)
+	for _, item := range input {
+		results = append(results, cdn.AFDEndpointProtocols(item.(string)))
+	}
+
+	return &results
+}
+
+func flattenFrontDoorRouteSupportedProtocols(input *[]cdn.AFDEndpointProtocols) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, string(item))
+	}
+
+	return results
+}
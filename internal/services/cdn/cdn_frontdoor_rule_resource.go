@@ -0,0 +1,250 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorRule() *pluginsdk.Resource {
+	resource := &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorRuleCreate,
+		Read:   resourceCdnFrontDoorRuleRead,
+		Update: resourceCdnFrontDoorRuleUpdate,
+		Delete: resourceCdnFrontDoorRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.EndpointDeliveryRuleName(),
+			},
+
+			"cdn_frontdoor_rule_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorRuleSetID,
+			},
+
+			"order": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"behavior_on_match": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cdn.MatchProcessingBehaviorContinue),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.MatchProcessingBehaviorContinue),
+					string(cdn.MatchProcessingBehaviorStop),
+				}, false),
+			},
+		},
+	}
+
+	for k, v := range deliveryRuleConditionsAndActionsSchema() {
+		resource.Schema[k] = v
+	}
+
+	return resource
+}
+
+func resourceCdnFrontDoorRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	ruleSetId, err := parse.FrontDoorRuleSetID(d.Get("cdn_frontdoor_rule_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorRuleID(ruleSetId.SubscriptionId, ruleSetId.ResourceGroup, ruleSetId.ProfileName, ruleSetId.RuleSetName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_rule", id.ID())
+	}
+
+	input := expandFrontDoorRuleConditionsAndActionsInput(d)
+	actions, err := expandDeliveryRuleActions(input)
+	if err != nil {
+		return fmt.Errorf("expanding actions: %+v", err)
+	}
+
+	props := cdn.Rule{
+		RuleProperties: &cdn.RuleProperties{
+			Actions:                 &actions,
+			Conditions:              expandDeliveryRuleConditions(input),
+			MatchProcessingBehavior: cdn.MatchProcessingBehavior(d.Get("behavior_on_match").(string)),
+			Order:                   utils.Int32(int32(d.Get("order").(int))),
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorRuleRead(d, meta)
+}
+
+func resourceCdnFrontDoorRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RuleName)
+	d.Set("cdn_frontdoor_rule_set_id", parse.NewFrontDoorRuleSetID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.RuleSetName).ID())
+
+	if props := resp.RuleProperties; props != nil {
+		d.Set("behavior_on_match", string(props.MatchProcessingBehavior))
+
+		order := 0
+		if props.Order != nil {
+			order = int(*props.Order)
+		}
+		d.Set("order", order)
+
+		conditions, err := flattenDeliveryRuleConditions(props.Conditions)
+		if err != nil {
+			return fmt.Errorf("flattening conditions: %+v", err)
+		}
+		for key, value := range *conditions {
+			if err := d.Set(key, value); err != nil {
+				return fmt.Errorf("setting `%s`: %+v", key, err)
+			}
+		}
+
+		actions, err := flattenDeliveryRuleActions(props.Actions)
+		if err != nil {
+			return fmt.Errorf("flattening actions: %+v", err)
+		}
+		for key, value := range *actions {
+			if err := d.Set(key, value); err != nil {
+				return fmt.Errorf("setting `%s`: %+v", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := expandFrontDoorRuleConditionsAndActionsInput(d)
+	actions, err := expandDeliveryRuleActions(input)
+	if err != nil {
+		return fmt.Errorf("expanding actions: %+v", err)
+	}
+
+	props := cdn.RuleUpdateParameters{
+		RuleUpdatePropertiesParameters: &cdn.RuleUpdatePropertiesParameters{
+			Actions:                 &actions,
+			Conditions:              expandDeliveryRuleConditions(input),
+			MatchProcessingBehavior: cdn.MatchProcessingBehavior(d.Get("behavior_on_match").(string)),
+			Order:                   utils.Int32(int32(d.Get("order").(int))),
+		},
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName, props)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorRuleRead(d, meta)
+}
+
+func resourceCdnFrontDoorRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// expandFrontDoorRuleConditionsAndActionsInput builds the map consumed by the shared Delivery Rule expand functions
+func expandFrontDoorRuleConditionsAndActionsInput(d *pluginsdk.ResourceData) map[string]interface{} {
+	input := make(map[string]interface{})
+	for key := range deliveryRuleConditionsAndActionsSchema() {
+		input[key] = d.Get(key).([]interface{})
+	}
+
+	return input
+}
@@ -0,0 +1,161 @@
+package cdn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorRuleResource struct{}
+
+func TestAccCdnFrontDoorRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_rule", "test")
+	r := CdnFrontDoorRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCdnFrontDoorRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_rule", "test")
+	r := CdnFrontDoorRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCdnFrontDoorRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_rule", "test")
+	r := CdnFrontDoorRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CdnFrontDoorRuleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FrontDoorRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Cdn.FrontDoorRulesClient.Get(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return utils.Bool(true), nil
+}
+
+func (r CdnFrontDoorRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_rule" "test" {
+  name                      = "acctestrule%d"
+  cdn_frontdoor_rule_set_id = azurerm_cdn_frontdoor_rule_set.test.id
+  order                     = 1
+
+  request_scheme_condition {
+    match_values = ["HTTP"]
+  }
+
+  url_redirect_action {
+    redirect_type = "Found"
+    protocol      = "Https"
+  }
+}
+`, CdnFrontDoorRuleSetResource{}.basic(data), data.RandomInteger)
+}
+
+func (r CdnFrontDoorRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_rule" "import" {
+  name                      = azurerm_cdn_frontdoor_rule.test.name
+  cdn_frontdoor_rule_set_id = azurerm_cdn_frontdoor_rule.test.cdn_frontdoor_rule_set_id
+  order                     = azurerm_cdn_frontdoor_rule.test.order
+
+  request_scheme_condition {
+    match_values = ["HTTP"]
+  }
+
+  url_redirect_action {
+    redirect_type = "Found"
+    protocol      = "Https"
+  }
+}
+`, r.basic(data))
+}
+
+func (r CdnFrontDoorRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_rule" "test" {
+  name                      = "acctestrule%d"
+  cdn_frontdoor_rule_set_id = azurerm_cdn_frontdoor_rule_set.test.id
+  order                     = 2
+  behavior_on_match         = "Stop"
+
+  request_scheme_condition {
+    negate_condition = true
+    match_values     = ["HTTPS"]
+  }
+
+  url_path_condition {
+    operator     = "BeginsWith"
+    match_values = ["/content"]
+  }
+
+  modify_response_header_action {
+    action = "Overwrite"
+    name   = "Content-Type"
+    value  = "application/json"
+  }
+
+  url_rewrite_action {
+    source_pattern          = "/content"
+    destination             = "/index.html"
+    preserve_unmatched_path = false
+  }
+}
+`, CdnFrontDoorRuleSetResource{}.basic(data), data.RandomInteger)
+}
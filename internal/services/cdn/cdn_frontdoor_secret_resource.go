@@ -0,0 +1,272 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	keyvaultClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
+	keyvaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyvaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorSecret() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorSecretCreate,
+		Read:   resourceCdnFrontDoorSecretRead,
+		Delete: resourceCdnFrontDoorSecretDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorSecretID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"customer_certificate": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_certificate_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: keyvaultValidate.NestedItemIdWithOptionalVersion,
+						},
+
+						"subject_alternative_names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorSecretCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorSecretID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecretName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_secret", id.ID())
+	}
+
+	params, err := expandFrontDoorSecretCustomerCertificate(ctx, d.Get("customer_certificate").([]interface{}), meta.(*clients.Client))
+	if err != nil {
+		return fmt.Errorf("expanding `customer_certificate`: %+v", err)
+	}
+
+	props := cdn.Secret{
+		SecretProperties: &cdn.SecretProperties{
+			Parameters: params,
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.SecretName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorSecretRead(d, meta)
+}
+
+func resourceCdnFrontDoorSecretRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecretName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SecretName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	if props := resp.SecretProperties; props != nil && props.Parameters != nil {
+		if params, ok := props.Parameters.AsCustomerCertificateParameters(); ok {
+			customerCertificate, err := flattenFrontDoorSecretCustomerCertificate(ctx, params, keyVaultsClient)
+			if err != nil {
+				return fmt.Errorf("flattening `customer_certificate`: %+v", err)
+			}
+
+			if err := d.Set("customer_certificate", customerCertificate); err != nil {
+				return fmt.Errorf("setting `customer_certificate`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorSecretDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.SecretName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFrontDoorSecretCustomerCertificate(ctx context.Context, input []interface{}, clients *clients.Client) (cdn.BasicSecretParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	keyVaultCertId, err := keyvaultParse.ParseOptionallyVersionedNestedItemID(raw["key_vault_certificate_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	keyVaultIdRaw, err := clients.KeyVault.KeyVaultIDFromBaseUrl(ctx, clients.Resource, keyVaultCertId.KeyVaultBaseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", keyVaultCertId.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		return nil, fmt.Errorf("unexpected nil Key Vault ID retrieved at URL %q", keyVaultCertId.KeyVaultBaseUrl)
+	}
+	keyVaultId, err := keyvaultParse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	output := cdn.CustomerCertificateParameters{
+		SecretSource: &cdn.ResourceReference{
+			ID: utils.String(fmt.Sprintf("%s/secrets/%s", keyVaultId.ID(), keyVaultCertId.Name)),
+		},
+		UseLatestVersion: utils.Bool(keyVaultCertId.Version == ""),
+		Type:             cdn.TypeBasicSecretParametersTypeCustomerCertificate,
+	}
+
+	if keyVaultCertId.Version != "" {
+		output.SecretVersion = utils.String(keyVaultCertId.Version)
+	}
+
+	return output, nil
+}
+
+func flattenFrontDoorSecretCustomerCertificate(ctx context.Context, input *cdn.CustomerCertificateParameters, keyVaultsClient *keyvaultClient.Client) ([]interface{}, error) {
+	if input == nil || input.SecretSource == nil || input.SecretSource.ID == nil {
+		return []interface{}{}, nil
+	}
+
+	// the Secret Source is the Resource Manager ID of the Key Vault Secret backing the Certificate, e.g.
+	// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.KeyVault/vaults/{vault}/secrets/{name}
+	segments := strings.Split(*input.SecretSource.ID, "/secrets/")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected the Secret Source ID %q to contain a `/secrets/` segment", *input.SecretSource.ID)
+	}
+
+	keyVaultId, err := keyvaultParse.VaultID(segments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return nil, fmt.Errorf("looking up the Base URI for %s: %+v", *keyVaultId, err)
+	}
+
+	version := ""
+	if input.UseLatestVersion == nil || !*input.UseLatestVersion {
+		if input.SecretVersion != nil {
+			// the API returns the full Resource ID of the Secret Version
+			versionSegments := strings.Split(*input.SecretVersion, "/")
+			version = versionSegments[len(versionSegments)-1]
+		}
+	}
+
+	certId, err := keyvaultParse.NewNestedItemID(*keyVaultBaseUrl, "certificates", segments[1], version)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_certificate_id":  certId.ID(),
+			"subject_alternative_names": utils.FlattenStringSlice(input.SubjectAlternativeNames),
+		},
+	}, nil
+}
@@ -0,0 +1,246 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorSecurityPolicyCreate,
+		Read:   resourceCdnFrontDoorSecurityPolicyRead,
+		Delete: resourceCdnFrontDoorSecurityPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorSecurityPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"web_application_firewall": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cdn_frontdoor_firewall_policy_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"association": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									// this can be either a Front Door Endpoint or a Front Door Custom Domain
+									"domain_ids": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: azure.ValidateResourceID,
+										},
+									},
+
+									"patterns_to_match": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorSecurityPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorSecurityPolicyID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_security_policy", id.ID())
+	}
+
+	props := cdn.SecurityPolicy{
+		SecurityPolicyProperties: &cdn.SecurityPolicyProperties{
+			Parameters: expandFrontDoorSecurityPolicyWebApplicationFirewall(d.Get("web_application_firewall").([]interface{})),
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorSecurityPolicyRead(d, meta)
+}
+
+func resourceCdnFrontDoorSecurityPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecurityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SecurityPolicyName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	if props := resp.SecurityPolicyProperties; props != nil && props.Parameters != nil {
+		if params, ok := props.Parameters.AsSecurityPolicyWebApplicationFirewallParameters(); ok {
+			if err := d.Set("web_application_firewall", flattenFrontDoorSecurityPolicyWebApplicationFirewall(params)); err != nil {
+				return fmt.Errorf("setting `web_application_firewall`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorSecurityPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecurityPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecurityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandFrontDoorSecurityPolicyWebApplicationFirewall(input []interface{}) cdn.BasicSecurityPolicyParameters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	associations := make([]cdn.SecurityPolicyWebApplicationFirewallAssociation, 0)
+	for _, item := range v["association"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+
+		association := item.(map[string]interface{})
+		associations = append(associations, cdn.SecurityPolicyWebApplicationFirewallAssociation{
+			Domains:         expandFrontDoorResourceReferences(association["domain_ids"].([]interface{})),
+			PatternsToMatch: utils.ExpandStringSlice(association["patterns_to_match"].([]interface{})),
+		})
+	}
+
+	return cdn.SecurityPolicyWebApplicationFirewallParameters{
+		Associations: &associations,
+		WafPolicy:    expandFrontDoorResourceReference(v["cdn_frontdoor_firewall_policy_id"].(string)),
+		Type:         cdn.TypeWebApplicationFirewall,
+	}
+}
+
+func flattenFrontDoorSecurityPolicyWebApplicationFirewall(input *cdn.SecurityPolicyWebApplicationFirewallParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	associations := make([]interface{}, 0)
+	if input.Associations != nil {
+		for _, item := range *input.Associations {
+			associations = append(associations, map[string]interface{}{
+				"domain_ids":        flattenFrontDoorResourceReferences(item.Domains),
+				"patterns_to_match": utils.FlattenStringSlice(item.PatternsToMatch),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"association":                      associations,
+			"cdn_frontdoor_firewall_policy_id": flattenFrontDoorResourceReference(input.WafPolicy),
+		},
+	}
+}
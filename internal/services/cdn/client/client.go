@@ -6,9 +6,18 @@ import (
 )
 
 type Client struct {
-	CustomDomainsClient *cdn.CustomDomainsClient
-	EndpointsClient     *cdn.EndpointsClient
-	ProfilesClient      *cdn.ProfilesClient
+	CustomDomainsClient             *cdn.CustomDomainsClient
+	EndpointsClient                 *cdn.EndpointsClient
+	FrontDoorCustomDomainsClient    *cdn.AFDCustomDomainsClient
+	FrontDoorEndpointsClient        *cdn.AFDEndpointsClient
+	FrontDoorOriginGroupsClient     *cdn.AFDOriginGroupsClient
+	FrontDoorOriginsClient          *cdn.AFDOriginsClient
+	FrontDoorRoutesClient           *cdn.RoutesClient
+	FrontDoorRulesClient            *cdn.RulesClient
+	FrontDoorRuleSetsClient         *cdn.RuleSetsClient
+	FrontDoorSecretsClient          *cdn.SecretsClient
+	FrontDoorSecurityPoliciesClient *cdn.SecurityPoliciesClient
+	ProfilesClient                  *cdn.ProfilesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,12 +27,48 @@ func NewClient(o *common.ClientOptions) *Client {
 	endpointsClient := cdn.NewEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&endpointsClient.Client, o.ResourceManagerAuthorizer)
 
+	frontDoorCustomDomainsClient := cdn.NewAFDCustomDomainsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorCustomDomainsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorEndpointsClient := cdn.NewAFDEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorOriginGroupsClient := cdn.NewAFDOriginGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorOriginGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorOriginsClient := cdn.NewAFDOriginsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorOriginsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRoutesClient := cdn.NewRoutesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRoutesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRulesClient := cdn.NewRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorRuleSetsClient := cdn.NewRuleSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorRuleSetsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorSecretsClient := cdn.NewSecretsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorSecretsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontDoorSecurityPoliciesClient := cdn.NewSecurityPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorSecurityPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	profilesClient := cdn.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&profilesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		CustomDomainsClient: &customDomainsClient,
-		EndpointsClient:     &endpointsClient,
-		ProfilesClient:      &profilesClient,
+		CustomDomainsClient:             &customDomainsClient,
+		EndpointsClient:                 &endpointsClient,
+		FrontDoorCustomDomainsClient:    &frontDoorCustomDomainsClient,
+		FrontDoorEndpointsClient:        &frontDoorEndpointsClient,
+		FrontDoorOriginGroupsClient:     &frontDoorOriginGroupsClient,
+		FrontDoorOriginsClient:          &frontDoorOriginsClient,
+		FrontDoorRoutesClient:           &frontDoorRoutesClient,
+		FrontDoorRulesClient:            &frontDoorRulesClient,
+		FrontDoorRuleSetsClient:         &frontDoorRuleSetsClient,
+		FrontDoorSecretsClient:          &frontDoorSecretsClient,
+		FrontDoorSecurityPoliciesClient: &frontDoorSecurityPoliciesClient,
+		ProfilesClient:                  &profilesClient,
 	}
 }
@@ -11,150 +11,162 @@ import (
 )
 
 func endpointDeliveryRule() *pluginsdk.Schema {
+	ruleSchema := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.EndpointDeliveryRuleName(),
+		},
+
+		"order": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+
+	for k, v := range deliveryRuleConditionsAndActionsSchema() {
+		ruleSchema[k] = v
+	}
+
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
 		Optional: true,
 		Elem: &pluginsdk.Resource{
-			Schema: map[string]*pluginsdk.Schema{
-				"name": {
-					Type:         pluginsdk.TypeString,
-					Required:     true,
-					ValidateFunc: validate.EndpointDeliveryRuleName(),
-				},
-
-				"order": {
-					Type:         pluginsdk.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntAtLeast(1),
-				},
-
-				"cookies_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.Cookies(),
-				},
-
-				"http_version_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.HTTPVersion(),
-				},
-
-				"device_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem:     deliveryruleconditions.Device(),
-				},
-
-				"post_arg_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.PostArg(),
-				},
-
-				"query_string_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.QueryString(),
-				},
-
-				"remote_address_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.RemoteAddress(),
-				},
-
-				"request_body_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.RequestBody(),
-				},
-
-				"request_header_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.RequestHeader(),
-				},
-
-				"request_method_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem:     deliveryruleconditions.RequestMethod(),
-				},
-
-				"request_scheme_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem:     deliveryruleconditions.RequestScheme(),
-				},
-
-				"request_uri_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.RequestURI(),
-				},
-
-				"url_file_extension_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.URLFileExtension(),
-				},
-
-				"url_file_name_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.URLFileName(),
-				},
-
-				"url_path_condition": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleconditions.URLPath(),
-				},
-
-				"cache_expiration_action": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem:     deliveryruleactions.CacheExpiration(),
-				},
-
-				"cache_key_query_string_action": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem:     deliveryruleactions.CacheKeyQueryString(),
-				},
-
-				"modify_request_header_action": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleactions.ModifyRequestHeader(),
-				},
-
-				"modify_response_header_action": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					Elem:     deliveryruleactions.ModifyResponseHeader(),
-				},
-
-				"url_redirect_action": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem:     deliveryruleactions.URLRedirect(),
-				},
-
-				"url_rewrite_action": {
-					Type:     pluginsdk.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem:     deliveryruleactions.URLRewrite(),
-				},
-			},
+			Schema: ruleSchema,
+		},
+	}
+}
+
+// deliveryRuleConditionsAndActionsSchema returns the conditions and actions which make up a Delivery Rule,
+// these are shared between the CDN Endpoint Delivery Rules and the Front Door Rules
+func deliveryRuleConditionsAndActionsSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cookies_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.Cookies(),
+		},
+
+		"http_version_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.HTTPVersion(),
+		},
+
+		"device_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     deliveryruleconditions.Device(),
+		},
+
+		"post_arg_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.PostArg(),
+		},
+
+		"query_string_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.QueryString(),
+		},
+
+		"remote_address_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.RemoteAddress(),
+		},
+
+		"request_body_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.RequestBody(),
+		},
+
+		"request_header_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.RequestHeader(),
+		},
+
+		"request_method_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     deliveryruleconditions.RequestMethod(),
+		},
+
+		"request_scheme_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     deliveryruleconditions.RequestScheme(),
+		},
+
+		"request_uri_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.RequestURI(),
+		},
+
+		"url_file_extension_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.URLFileExtension(),
+		},
+
+		"url_file_name_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.URLFileName(),
+		},
+
+		"url_path_condition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleconditions.URLPath(),
+		},
+
+		"cache_expiration_action": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     deliveryruleactions.CacheExpiration(),
+		},
+
+		"cache_key_query_string_action": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     deliveryruleactions.CacheKeyQueryString(),
+		},
+
+		"modify_request_header_action": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleactions.ModifyRequestHeader(),
+		},
+
+		"modify_response_header_action": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem:     deliveryruleactions.ModifyResponseHeader(),
+		},
+
+		"url_redirect_action": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     deliveryruleactions.URLRedirect(),
+		},
+
+		"url_rewrite_action": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     deliveryruleactions.URLRewrite(),
 		},
 	}
 }
@@ -0,0 +1,51 @@
+package dns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func dataSourceDnsAAAARecord() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDnsAAAARecordRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: dataSourceDnsRecordSetSchema(map[string]*pluginsdk.Schema{
+			"records": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"target_resource_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+func dataSourceDnsAAAARecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	props, err := dataSourceDnsRecordSetRead(d, meta, dns.AAAA, func(subscriptionId, resourceGroup, zoneName, name string) string {
+		return parse.NewAaaaRecordID(subscriptionId, resourceGroup, zoneName, name).ID()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("records", flattenAzureRmDnsAaaaRecords(props.AaaaRecords)); err != nil {
+		return fmt.Errorf("setting `records`: %+v", err)
+	}
+
+	d.Set("target_resource_id", flattenDnsRecordSetTargetResourceId(props.TargetResource))
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package dns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func dataSourceDnsCaaRecord() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDnsCaaRecordRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: dataSourceDnsRecordSetSchema(map[string]*pluginsdk.Schema{
+			"record": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"flags": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"tag": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"value": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Set: resourceDnsCaaRecordHash,
+			},
+		}),
+	}
+}
+
+func dataSourceDnsCaaRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	props, err := dataSourceDnsRecordSetRead(d, meta, dns.CAA, func(subscriptionId, resourceGroup, zoneName, name string) string {
+		return parse.NewCaaRecordID(subscriptionId, resourceGroup, zoneName, name).ID()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("record", flattenAzureRmDnsCaaRecords(props.CaaRecords)); err != nil {
+		return fmt.Errorf("setting `record`: %+v", err)
+	}
+
+	return nil
+}
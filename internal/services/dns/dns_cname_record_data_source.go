@@ -0,0 +1,50 @@
+package dns
+
+import (
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func dataSourceDnsCNameRecord() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDnsCNameRecordRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: dataSourceDnsRecordSetSchema(map[string]*pluginsdk.Schema{
+			"record": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"target_resource_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+func dataSourceDnsCNameRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	props, err := dataSourceDnsRecordSetRead(d, meta, dns.CNAME, func(subscriptionId, resourceGroup, zoneName, name string) string {
+		return parse.NewCnameRecordID(subscriptionId, resourceGroup, zoneName, name).ID()
+	})
+	if err != nil {
+		return err
+	}
+
+	cname := ""
+	if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+		cname = *props.CnameRecord.Cname
+	}
+	d.Set("record", cname)
+
+	d.Set("target_resource_id", flattenDnsRecordSetTargetResourceId(props.TargetResource))
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package dns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func dataSourceDnsMxRecord() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDnsMxRecordRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: dataSourceDnsRecordSetSchema(map[string]*pluginsdk.Schema{
+			"record": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"preference": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"exchange": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Set: resourceDnsMxRecordHash,
+			},
+		}),
+	}
+}
+
+func dataSourceDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	props, err := dataSourceDnsRecordSetRead(d, meta, dns.MX, func(subscriptionId, resourceGroup, zoneName, name string) string {
+		return parse.NewMxRecordID(subscriptionId, resourceGroup, zoneName, name).ID()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("record", flattenAzureRmDnsMxRecords(props.MxRecords)); err != nil {
+		return fmt.Errorf("setting `record`: %+v", err)
+	}
+
+	return nil
+}
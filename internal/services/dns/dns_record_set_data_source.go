@@ -0,0 +1,101 @@
+package dns
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// dataSourceDnsRecordSetSchema returns the schema shared by the DNS Record Data Sources, combined with the
+// record-type specific fields in `recordSchema`
+func dataSourceDnsRecordSetSchema(recordSchema map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+		"zone_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ttl": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": tags.SchemaDataSource(),
+	}
+
+	for k, v := range recordSchema {
+		s[k] = v
+	}
+
+	return s
+}
+
+// dataSourceDnsRecordSetRead retrieves the Record Set of the specified type, sets the ID and the fields common to
+// all DNS Record Data Sources and returns the properties so that the record-type specific fields can be set
+func dataSourceDnsRecordSetRead(d *pluginsdk.ResourceData, meta interface{}, recordType dns.RecordType, newId func(subscriptionId, resourceGroup, zoneName, name string) string) (*dns.RecordSetProperties, error) {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, zoneName, name, recordType)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("DNS %s Record %q (Zone %q / Resource Group %q) was not found", recordType, name, zoneName, resourceGroup)
+		}
+		return nil, fmt.Errorf("retrieving DNS %s Record %q (Zone %q / Resource Group %q): %+v", recordType, name, zoneName, resourceGroup, err)
+	}
+
+	d.SetId(newId(subscriptionId, resourceGroup, zoneName, name))
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("zone_name", zoneName)
+
+	props := resp.RecordSetProperties
+	if props == nil {
+		props = &dns.RecordSetProperties{}
+	}
+
+	d.Set("fqdn", props.Fqdn)
+	d.Set("ttl", props.TTL)
+
+	if err := tags.FlattenAndSet(d, props.Metadata); err != nil {
+		return nil, err
+	}
+
+	return props, nil
+}
+
+func flattenDnsRecordSetTargetResourceId(input *dns.SubResource) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	return *input.ID
+}
@@ -0,0 +1,278 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceDnsRecords() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDnsRecordsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"zone_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"record_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dns.A),
+					string(dns.AAAA),
+					string(dns.CAA),
+					string(dns.CNAME),
+					string(dns.MX),
+					string(dns.NS),
+					string(dns.PTR),
+					string(dns.SOA),
+					string(dns.SRV),
+					string(dns.TXT),
+				}, false),
+			},
+
+			"name_prefix": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"record_sets": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"fqdn": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"records": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"target_resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDnsRecordsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDnsZoneID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string))
+	namePrefix := d.Get("name_prefix").(string)
+
+	var iterator dns.RecordSetListResultIterator
+	var err error
+	recordType := d.Get("record_type").(string)
+	if recordType != "" {
+		iterator, err = client.ListByTypeComplete(ctx, id.ResourceGroup, id.Name, dns.RecordType(recordType), nil, "")
+	} else {
+		iterator, err = client.ListByDNSZoneComplete(ctx, id.ResourceGroup, id.Name, nil, "")
+	}
+	if err != nil {
+		return fmt.Errorf("listing Record Sets for %s: %+v", id, err)
+	}
+
+	recordSets := make([]interface{}, 0)
+	for iterator.NotDone() {
+		recordSet := iterator.Value()
+		if recordSet.Name != nil && strings.HasPrefix(*recordSet.Name, namePrefix) {
+			recordSets = append(recordSets, flattenDnsRecordSet(recordSet))
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Record Sets for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+	d.Set("zone_name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if err := d.Set("record_sets", recordSets); err != nil {
+		return fmt.Errorf("setting `record_sets`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDnsRecordSet(input dns.RecordSet) map[string]interface{} {
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	// the type is returned in the form `Microsoft.Network/dnszones/A`
+	recordType := ""
+	if input.Type != nil {
+		recordType = (*input.Type)[strings.LastIndex(*input.Type, "/")+1:]
+	}
+
+	fqdn := ""
+	ttl := 0
+	targetResourceId := ""
+	records := make([]interface{}, 0)
+	metadata := make(map[string]interface{})
+	if props := input.RecordSetProperties; props != nil {
+		if props.Fqdn != nil {
+			fqdn = *props.Fqdn
+		}
+		if props.TTL != nil {
+			ttl = int(*props.TTL)
+		}
+		targetResourceId = flattenDnsRecordSetTargetResourceId(props.TargetResource)
+		records = flattenDnsRecordSetValues(props)
+		metadata = tags.Flatten(props.Metadata)
+	}
+
+	return map[string]interface{}{
+		"id":                 id,
+		"name":               name,
+		"type":               recordType,
+		"fqdn":               fqdn,
+		"ttl":                ttl,
+		"records":            records,
+		"target_resource_id": targetResourceId,
+		"tags":               metadata,
+	}
+}
+
+// flattenDnsRecordSetValues returns the values of each record within the Record Set in zone file notation
+func flattenDnsRecordSetValues(input *dns.RecordSetProperties) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input.ARecords != nil {
+		for _, v := range *input.ARecords {
+			if v.Ipv4Address != nil {
+				results = append(results, *v.Ipv4Address)
+			}
+		}
+	}
+
+	if input.AaaaRecords != nil {
+		for _, v := range *input.AaaaRecords {
+			if v.Ipv6Address != nil {
+				results = append(results, *v.Ipv6Address)
+			}
+		}
+	}
+
+	if input.CaaRecords != nil {
+		for _, v := range *input.CaaRecords {
+			if v.Flags != nil && v.Tag != nil && v.Value != nil {
+				results = append(results, fmt.Sprintf("%d %s %q", *v.Flags, *v.Tag, *v.Value))
+			}
+		}
+	}
+
+	if input.CnameRecord != nil && input.CnameRecord.Cname != nil {
+		results = append(results, *input.CnameRecord.Cname)
+	}
+
+	if input.MxRecords != nil {
+		for _, v := range *input.MxRecords {
+			if v.Preference != nil && v.Exchange != nil {
+				results = append(results, fmt.Sprintf("%d %s", *v.Preference, *v.Exchange))
+			}
+		}
+	}
+
+	if input.NsRecords != nil {
+		for _, v := range *input.NsRecords {
+			if v.Nsdname != nil {
+				results = append(results, *v.Nsdname)
+			}
+		}
+	}
+
+	if input.PtrRecords != nil {
+		for _, v := range *input.PtrRecords {
+			if v.Ptrdname != nil {
+				results = append(results, *v.Ptrdname)
+			}
+		}
+	}
+
+	if v := input.SoaRecord; v != nil {
+		if v.Host != nil && v.Email != nil && v.SerialNumber != nil && v.RefreshTime != nil && v.RetryTime != nil && v.ExpireTime != nil && v.MinimumTTL != nil {
+			results = append(results, fmt.Sprintf("%s %s %d %d %d %d %d", *v.Host, *v.Email, *v.SerialNumber, *v.RefreshTime, *v.RetryTime, *v.ExpireTime, *v.MinimumTTL))
+		}
+	}
+
+	if input.SrvRecords != nil {
+		for _, v := range *input.SrvRecords {
+			if v.Priority != nil && v.Weight != nil && v.Port != nil && v.Target != nil {
+				results = append(results, fmt.Sprintf("%d %d %d %s", *v.Priority, *v.Weight, *v.Port, *v.Target))
+			}
+		}
+	}
+
+	if input.TxtRecords != nil {
+		for _, v := range *input.TxtRecords {
+			if v.Value != nil {
+				results = append(results, strings.Join(*v.Value, ""))
+			}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,155 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DnsRecordsDataSource struct{}
+
+func TestAccDnsRecordsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_records", "test")
+	r := DnsRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				// the SOA and NS records are created alongside the zone
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("5"),
+			),
+		},
+	})
+}
+
+func TestAccDnsRecordsDataSource_recordType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_records", "test")
+	r := DnsRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.recordType(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("2"),
+				check.That(data.ResourceName).Key("record_sets.0.type").HasValue("A"),
+				check.That(data.ResourceName).Key("record_sets.0.ttl").HasValue("300"),
+				check.That(data.ResourceName).Key("record_sets.0.records.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccDnsRecordsDataSource_namePrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_records", "test")
+	r := DnsRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.namePrefix(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (DnsRecordsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_a_record" "web" {
+  name                = "web"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+  records             = ["1.2.3.4", "1.2.4.5"]
+}
+
+resource "azurerm_dns_a_record" "api" {
+  name                = "api"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+  records             = ["1.2.3.6", "1.2.4.7"]
+}
+
+resource "azurerm_dns_txt_record" "web" {
+  name                = "web-verification"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    value = "verification"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsRecordsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+
+  depends_on = [
+    azurerm_dns_a_record.web,
+    azurerm_dns_a_record.api,
+    azurerm_dns_txt_record.web,
+  ]
+}
+`, r.template(data))
+}
+
+func (r DnsRecordsDataSource) recordType(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  record_type         = "A"
+
+  depends_on = [
+    azurerm_dns_a_record.web,
+    azurerm_dns_a_record.api,
+    azurerm_dns_txt_record.web,
+  ]
+}
+`, r.template(data))
+}
+
+func (r DnsRecordsDataSource) namePrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_records" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  name_prefix         = "web"
+
+  depends_on = [
+    azurerm_dns_a_record.web,
+    azurerm_dns_a_record.api,
+    azurerm_dns_txt_record.web,
+  ]
+}
+`, r.template(data))
+}
@@ -0,0 +1,252 @@
+package dns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// the DNSSEC Configuration is a singleton below the DNS Zone and is always named `default`
+const dnssecConfigName = "default"
+
+func resourceDnsZoneDnssecConfig() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDnsZoneDnssecConfigCreate,
+		Read:   resourceDnsZoneDnssecConfigRead,
+		Delete: resourceDnsZoneDnssecConfigDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DnssecConfigID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsZoneID,
+			},
+
+			"signing_key": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"delegation_signer_info": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"digest_algorithm_type": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"digest_value": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"record": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"flags": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"key_tag": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"public_key": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"security_algorithm_type": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDnsZoneDnssecConfigCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.DnssecConfigsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := parse.DnsZoneID(d.Get("dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewDnssecConfigID(zoneId.SubscriptionId, zoneId.ResourceGroup, zoneId.Name, dnssecConfigName)
+	sdkZoneId := dnssecconfigs.NewDnsZoneID(zoneId.SubscriptionId, zoneId.ResourceGroup, zoneId.Name)
+
+	existing, err := client.Get(ctx, sdkZoneId)
+	if err != nil && !response.WasNotFound(existing.HttpResponse) {
+		return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_dns_zone_dnssec_config", id.ID())
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, sdkZoneId, dnssecconfigs.DefaultCreateOrUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDnsZoneDnssecConfigRead(d, meta)
+}
+
+func resourceDnsZoneDnssecConfigRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.DnssecConfigsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnssecConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnszoneName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("dns_zone_id", parse.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnszoneName).ID())
+
+	signingKeys := make([]interface{}, 0)
+	if model := resp.Model; model != nil && model.Properties != nil {
+		signingKeys = flattenDnsZoneDnssecConfigSigningKeys(model.Properties.SigningKeys)
+	}
+	if err := d.Set("signing_key", signingKeys); err != nil {
+		return fmt.Errorf("setting `signing_key`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceDnsZoneDnssecConfigDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.DnssecConfigsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnssecConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnszoneName), dnssecconfigs.DefaultDeleteOperationOptions()); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func flattenDnsZoneDnssecConfigSigningKeys(input *[]dnssecconfigs.SigningKey) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		delegationSignerInfo := make([]interface{}, 0)
+		if v.DelegationSignerInfo != nil {
+			for _, info := range *v.DelegationSignerInfo {
+				digestAlgorithmType := 0
+				if info.DigestAlgorithmType != nil {
+					digestAlgorithmType = int(*info.DigestAlgorithmType)
+				}
+
+				digestValue := ""
+				if info.DigestValue != nil {
+					digestValue = *info.DigestValue
+				}
+
+				record := ""
+				if info.Record != nil {
+					record = *info.Record
+				}
+
+				delegationSignerInfo = append(delegationSignerInfo, map[string]interface{}{
+					"digest_algorithm_type": digestAlgorithmType,
+					"digest_value":          digestValue,
+					"record":                record,
+				})
+			}
+		}
+
+		flags := 0
+		if v.Flags != nil {
+			flags = int(*v.Flags)
+		}
+
+		keyTag := 0
+		if v.KeyTag != nil {
+			keyTag = int(*v.KeyTag)
+		}
+
+		protocol := 0
+		if v.Protocol != nil {
+			protocol = int(*v.Protocol)
+		}
+
+		publicKey := ""
+		if v.PublicKey != nil {
+			publicKey = *v.PublicKey
+		}
+
+		securityAlgorithmType := 0
+		if v.SecurityAlgorithmType != nil {
+			securityAlgorithmType = int(*v.SecurityAlgorithmType)
+		}
+
+		output = append(output, map[string]interface{}{
+			"delegation_signer_info":  delegationSignerInfo,
+			"flags":                   flags,
+			"key_tag":                 keyTag,
+			"protocol":                protocol,
+			"public_key":              publicKey,
+			"security_algorithm_type": securityAlgorithmType,
+		})
+	}
+
+	return output
+}
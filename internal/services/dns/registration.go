@@ -28,22 +28,33 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_dns_zone": dataSourceDnsZone(),
+		"azurerm_dns_a_record":     dataSourceDnsARecord(),
+		"azurerm_dns_aaaa_record":  dataSourceDnsAAAARecord(),
+		"azurerm_dns_caa_record":   dataSourceDnsCaaRecord(),
+		"azurerm_dns_cname_record": dataSourceDnsCNameRecord(),
+		"azurerm_dns_mx_record":    dataSourceDnsMxRecord(),
+		"azurerm_dns_ns_record":    dataSourceDnsNsRecord(),
+		"azurerm_dns_ptr_record":   dataSourceDnsPtrRecord(),
+		"azurerm_dns_records":      dataSourceDnsRecords(),
+		"azurerm_dns_srv_record":   dataSourceDnsSrvRecord(),
+		"azurerm_dns_txt_record":   dataSourceDnsTxtRecord(),
+		"azurerm_dns_zone":         dataSourceDnsZone(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_dns_a_record":     resourceDnsARecord(),
-		"azurerm_dns_aaaa_record":  resourceDnsAAAARecord(),
-		"azurerm_dns_caa_record":   resourceDnsCaaRecord(),
-		"azurerm_dns_cname_record": resourceDnsCNameRecord(),
-		"azurerm_dns_mx_record":    resourceDnsMxRecord(),
-		"azurerm_dns_ns_record":    resourceDnsNsRecord(),
-		"azurerm_dns_ptr_record":   resourceDnsPtrRecord(),
-		"azurerm_dns_srv_record":   resourceDnsSrvRecord(),
-		"azurerm_dns_txt_record":   resourceDnsTxtRecord(),
-		"azurerm_dns_zone":         resourceDnsZone(),
+		"azurerm_dns_a_record":           resourceDnsARecord(),
+		"azurerm_dns_aaaa_record":        resourceDnsAAAARecord(),
+		"azurerm_dns_caa_record":         resourceDnsCaaRecord(),
+		"azurerm_dns_cname_record":       resourceDnsCNameRecord(),
+		"azurerm_dns_mx_record":          resourceDnsMxRecord(),
+		"azurerm_dns_ns_record":          resourceDnsNsRecord(),
+		"azurerm_dns_ptr_record":         resourceDnsPtrRecord(),
+		"azurerm_dns_srv_record":         resourceDnsSrvRecord(),
+		"azurerm_dns_txt_record":         resourceDnsTxtRecord(),
+		"azurerm_dns_zone":               resourceDnsZone(),
+		"azurerm_dns_zone_dnssec_config": resourceDnsZoneDnssecConfig(),
 	}
 }
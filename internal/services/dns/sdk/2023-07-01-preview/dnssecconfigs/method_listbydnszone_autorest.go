@@ -0,0 +1,183 @@
+package dnssecconfigs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListByDnsZoneResponse struct {
+	HttpResponse *http.Response
+	Model        *[]DnssecConfig
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (ListByDnsZoneResponse, error)
+}
+
+type ListByDnsZoneCompleteResult struct {
+	Items []DnssecConfig
+}
+
+func (r ListByDnsZoneResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r ListByDnsZoneResponse) LoadMore(ctx context.Context) (resp ListByDnsZoneResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// ListByDnsZone ...
+func (c DnssecConfigsClient) ListByDnsZone(ctx context.Context, id DnsZoneId) (resp ListByDnsZoneResponse, err error) {
+	req, err := c.preparerForListByDnsZone(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnssecconfigs.DnssecConfigsClient", "ListByDnsZone", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnssecconfigs.DnssecConfigsClient", "ListByDnsZone", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForListByDnsZone(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnssecconfigs.DnssecConfigsClient", "ListByDnsZone", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// ListByDnsZoneComplete retrieves all of the results into a single object
+func (c DnssecConfigsClient) ListByDnsZoneComplete(ctx context.Context, id DnsZoneId) (ListByDnsZoneCompleteResult, error) {
+	return c.ListByDnsZoneCompleteMatchingPredicate(ctx, id, DnssecConfigPredicate{})
+}
+
+// ListByDnsZoneCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c DnssecConfigsClient) ListByDnsZoneCompleteMatchingPredicate(ctx context.Context, id DnsZoneId, predicate DnssecConfigPredicate) (resp ListByDnsZoneCompleteResult, err error) {
+	items := make([]DnssecConfig, 0)
+
+	page, err := c.ListByDnsZone(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := ListByDnsZoneCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForListByDnsZone prepares the ListByDnsZone request.
+func (c DnssecConfigsClient) preparerForListByDnsZone(ctx context.Context, id DnsZoneId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/dnssecConfigs", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForListByDnsZoneWithNextLink prepares the ListByDnsZone request with the given nextLink token.
+func (c DnssecConfigsClient) preparerForListByDnsZoneWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListByDnsZone handles the response to the ListByDnsZone request. The method always
+// closes the http.Response Body.
+func (c DnssecConfigsClient) responderForListByDnsZone(resp *http.Response) (result ListByDnsZoneResponse, err error) {
+	type page struct {
+		Values   []DnssecConfig `json:"value"`
+		NextLink *string        `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result ListByDnsZoneResponse, err error) {
+			req, err := c.preparerForListByDnsZoneWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "dnssecconfigs.DnssecConfigsClient", "ListByDnsZone", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "dnssecconfigs.DnssecConfigsClient", "ListByDnsZone", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForListByDnsZone(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "dnssecconfigs.DnssecConfigsClient", "ListByDnsZone", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,9 @@
+package dnssecconfigs
+
+type DnssecConfig struct {
+	Etag       *string           `json:"etag,omitempty"`
+	Id         *string           `json:"id,omitempty"`
+	Name       *string           `json:"name,omitempty"`
+	Properties *DnssecProperties `json:"properties,omitempty"`
+	Type       *string           `json:"type,omitempty"`
+}
@@ -3,11 +3,12 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-11-01/firewallpolicies"
 )
 
 type Client struct {
 	AzureFirewallsClient          *network.AzureFirewallsClient
-	FirewallPolicyClient          *network.FirewallPoliciesClient
+	FirewallPolicyClient          *firewallpolicies.FirewallPoliciesClient
 	FirewallPolicyRuleGroupClient *network.FirewallPolicyRuleCollectionGroupsClient
 }
 
@@ -15,7 +16,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	firewallsClient := network.NewAzureFirewallsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&firewallsClient.Client, o.ResourceManagerAuthorizer)
 
-	policyClient := network.NewFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	policyClient := firewallpolicies.NewFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&policyClient.Client, o.ResourceManagerAuthorizer)
 
 	policyRuleGroupClient := network.NewFirewallPolicyRuleCollectionGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -0,0 +1,110 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyApplicationRuleResource struct{}
+
+func TestAccFirewallPolicyApplicationRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FirewallPolicyApplicationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleExists(ctx, clients, state)
+}
+
+func (FirewallPolicyApplicationRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule" "test" {
+  name                     = "app_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "app_rule_collection1"
+  rule_collection_priority = 500
+  rule_collection_action   = "Deny"
+  source_addresses         = ["10.0.0.1"]
+  destination_fqdns        = ["pluginsdk.io"]
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+`, FirewallPolicyNetworkRuleResource{}.template(data))
+}
+
+func (FirewallPolicyApplicationRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule" "test" {
+  name                     = "app_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "app_rule_collection1"
+  rule_collection_priority = 600
+  rule_collection_action   = "Allow"
+  description              = "app rule"
+  source_addresses         = ["10.0.0.1", "10.0.0.2"]
+  destination_fqdn_tags    = ["WindowsDiagnostics"]
+
+  protocols {
+    type = "Http"
+    port = 80
+  }
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+`, FirewallPolicyNetworkRuleResource{}.template(data))
+}
@@ -4,15 +4,15 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-11-01/firewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func FirewallDataSourcePolicy() *pluginsdk.Resource {
@@ -126,11 +126,11 @@ func FirewallDataSourcePolicyRead(d *pluginsdk.ResourceData, meta interface{}) e
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := firewallpolicies.NewFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := client.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("%s was not found", id)
 		}
 
@@ -139,33 +139,48 @@ func FirewallDataSourcePolicyRead(d *pluginsdk.ResourceData, meta interface{}) e
 
 	d.SetId(id.ID())
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
-
-	if prop := resp.FirewallPolicyPropertiesFormat; prop != nil {
-		basePolicyID := ""
-		if resp.BasePolicy != nil && resp.BasePolicy.ID != nil {
-			basePolicyID = *resp.BasePolicy.ID
-		}
-		d.Set("base_policy_id", basePolicyID)
-		if err := d.Set("child_policies", flattenNetworkSubResourceID(prop.ChildPolicies)); err != nil {
-			return fmt.Errorf(`setting "child_policies": %+v`, err)
-		}
-		if err := d.Set("dns", flattenFirewallPolicyDNSSetting(prop.DNSSettings)); err != nil {
-			return fmt.Errorf(`setting "dns": %+v`, err)
+	d.Set("name", id.FirewallPolicyName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if prop := model.Properties; prop != nil {
+			basePolicyID := ""
+			if prop.BasePolicy != nil && prop.BasePolicy.Id != nil {
+				basePolicyID = *prop.BasePolicy.Id
+			}
+			d.Set("base_policy_id", basePolicyID)
+			if err := d.Set("child_policies", flattenFirewallPolicySubResourceIds(prop.ChildPolicies)); err != nil {
+				return fmt.Errorf(`setting "child_policies": %+v`, err)
+			}
+			if err := d.Set("dns", flattenFirewallPolicyDNSSettingWithDeprecatedProperties(prop.DnsSettings)); err != nil {
+				return fmt.Errorf(`setting "dns": %+v`, err)
+			}
+			if err := d.Set("firewalls", flattenFirewallPolicySubResourceIds(prop.Firewalls)); err != nil {
+				return fmt.Errorf(`setting "firewalls": %+v`, err)
+			}
+			if err := d.Set("rule_collection_groups", flattenFirewallPolicySubResourceIds(prop.RuleCollectionGroups)); err != nil {
+				return fmt.Errorf(`setting "rule_collection_groups": %+v`, err)
+			}
+			threatIntelMode := ""
+			if prop.ThreatIntelMode != nil {
+				threatIntelMode = string(*prop.ThreatIntelMode)
+			}
+			d.Set("threat_intelligence_mode", threatIntelMode)
+			if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(prop.ThreatIntelWhitelist)); err != nil {
+				return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
+			}
 		}
-		if err := d.Set("firewalls", flattenNetworkSubResourceID(prop.Firewalls)); err != nil {
-			return fmt.Errorf(`setting "firewalls": %+v`, err)
-		}
-		if err := d.Set("rule_collection_groups", flattenNetworkSubResourceID(prop.RuleCollectionGroups)); err != nil {
-			return fmt.Errorf(`setting "rule_collection_groups": %+v`, err)
+
+		var policyTags map[string]*string
+		if model.Tags != nil {
+			policyTags = tags.FromTypedObject(*model.Tags)
 		}
-		d.Set("threat_intelligence_mode", string(prop.ThreatIntelMode))
-		if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(resp.ThreatIntelWhitelist)); err != nil {
-			return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
+		if err := tags.FlattenAndSet(d, policyTags); err != nil {
+			return err
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
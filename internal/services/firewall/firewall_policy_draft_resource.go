@@ -0,0 +1,253 @@
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-11-01/firewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// a Firewall Policy can only have a single Draft, which is always named `default`
+const firewallPolicyDraftName = "default"
+
+func resourceFirewallPolicyDraft() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyDraftCreateUpdate,
+		Read:   resourceFirewallPolicyDraftRead,
+		Update: resourceFirewallPolicyDraftCreateUpdate,
+		Delete: resourceFirewallPolicyDraftDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyDraftID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: resourceFirewallPolicyDraftSchema(),
+	}
+}
+
+func resourceFirewallPolicyDraftSchema() map[string]*pluginsdk.Schema {
+	policySchema := resourceFirewallPolicySchema()
+
+	// the Draft API doesn't expose the deprecated `network_rule_fqdn_enabled` property
+	delete(policySchema["dns"].Elem.(*pluginsdk.Resource).Schema, "network_rule_fqdn_enabled")
+
+	return map[string]*pluginsdk.Schema{
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FirewallPolicyID,
+		},
+
+		"auto_learn_private_ranges_enabled": policySchema["auto_learn_private_ranges_enabled"],
+		"base_policy_id":                    policySchema["base_policy_id"],
+		"dns":                               policySchema["dns"],
+		"explicit_proxy":                    policySchema["explicit_proxy"],
+		"insights":                          policySchema["insights"],
+		"intrusion_detection":               policySchema["intrusion_detection"],
+		"private_ip_ranges":                 policySchema["private_ip_ranges"],
+		"sql_redirect_allowed":              policySchema["sql_redirect_allowed"],
+		"threat_intelligence_allowlist":     policySchema["threat_intelligence_allowlist"],
+		"threat_intelligence_mode":          policySchema["threat_intelligence_mode"],
+	}
+}
+
+func resourceFirewallPolicyDraftCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	policyId, err := parse.FirewallPolicyID(d.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPolicyDraftID(policyId.SubscriptionId, policyId.ResourceGroup, policyId.Name, firewallPolicyDraftName)
+	sdkPolicyId := firewallpolicies.NewFirewallPolicyID(policyId.SubscriptionId, policyId.ResourceGroup, policyId.Name)
+
+	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.FirewallPolicyDraftsGet(ctx, sdkPolicyId)
+		if err != nil && !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_firewall_policy_draft", id.ID())
+		}
+	}
+
+	payload := firewallpolicies.FirewallPolicyDraft{
+		Properties: expandFirewallPolicyDraftProperties(d),
+	}
+
+	if _, err := client.FirewallPolicyDraftsCreateOrUpdate(ctx, sdkPolicyId, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyDraftRead(d, meta)
+}
+
+func resourceFirewallPolicyDraftRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyDraftID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyId := parse.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName)
+	sdkPolicyId := firewallpolicies.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName)
+
+	resp, err := client.FirewallPolicyDraftsGet(ctx, sdkPolicyId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	var props *firewallpolicies.FirewallPolicyDraftProperties
+	if model := resp.Model; model != nil {
+		props = model.Properties
+	}
+
+	d.Set("firewall_policy_id", policyId.ID())
+
+	if props == nil {
+		props = &firewallpolicies.FirewallPolicyDraftProperties{}
+	}
+
+	basePolicyId := ""
+	if props.BasePolicy != nil && props.BasePolicy.Id != nil {
+		basePolicyId = *props.BasePolicy.Id
+	}
+	d.Set("base_policy_id", basePolicyId)
+
+	threatIntelMode := ""
+	if props.ThreatIntelMode != nil {
+		threatIntelMode = string(*props.ThreatIntelMode)
+	}
+	d.Set("threat_intelligence_mode", threatIntelMode)
+
+	if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(props.ThreatIntelWhitelist)); err != nil {
+		return fmt.Errorf("setting `threat_intelligence_allowlist`: %+v", err)
+	}
+
+	if err := d.Set("dns", flattenFirewallPolicyDNSSetting(props.DnsSettings)); err != nil {
+		return fmt.Errorf("setting `dns`: %+v", err)
+	}
+
+	if err := d.Set("explicit_proxy", flattenFirewallPolicyExplicitProxy(props.ExplicitProxy)); err != nil {
+		return fmt.Errorf("setting `explicit_proxy`: %+v", err)
+	}
+
+	if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(props.IntrusionDetection)); err != nil {
+		return fmt.Errorf("setting `intrusion_detection`: %+v", err)
+	}
+
+	if err := d.Set("insights", flattenFirewallPolicyInsights(props.Insights)); err != nil {
+		return fmt.Errorf("setting `insights`: %+v", err)
+	}
+
+	var privateIPRanges []interface{}
+	autoLearnPrivateRanges := false
+	if props.Snat != nil {
+		privateIPRanges = utils.FlattenStringSlice(props.Snat.PrivateRanges)
+		autoLearnPrivateRanges = props.Snat.AutoLearnPrivateRanges != nil && *props.Snat.AutoLearnPrivateRanges == firewallpolicies.AutoLearnPrivateRangesModeEnabled
+	}
+	if err := d.Set("private_ip_ranges", privateIPRanges); err != nil {
+		return fmt.Errorf("setting `private_ip_ranges`: %+v", err)
+	}
+	d.Set("auto_learn_private_ranges_enabled", autoLearnPrivateRanges)
+
+	sqlRedirectAllowed := false
+	if props.Sql != nil && props.Sql.AllowSqlRedirect != nil {
+		sqlRedirectAllowed = *props.Sql.AllowSqlRedirect
+	}
+	d.Set("sql_redirect_allowed", sqlRedirectAllowed)
+
+	return nil
+}
+
+func resourceFirewallPolicyDraftDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyDraftID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	resp, err := client.FirewallPolicyDraftsDelete(ctx, firewallpolicies.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName))
+	if err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandFirewallPolicyDraftProperties(d *pluginsdk.ResourceData) *firewallpolicies.FirewallPolicyDraftProperties {
+	threatIntelMode := firewallpolicies.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string))
+	autoLearnPrivateRanges := firewallpolicies.AutoLearnPrivateRangesModeDisabled
+	if d.Get("auto_learn_private_ranges_enabled").(bool) {
+		autoLearnPrivateRanges = firewallpolicies.AutoLearnPrivateRangesModeEnabled
+	}
+
+	output := &firewallpolicies.FirewallPolicyDraftProperties{
+		DnsSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+		ExplicitProxy:        expandFirewallPolicyExplicitProxy(d.Get("explicit_proxy").([]interface{})),
+		Insights:             expandFirewallPolicyInsights(d.Get("insights").([]interface{})),
+		IntrusionDetection:   expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
+		ThreatIntelMode:      &threatIntelMode,
+		ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
+		Snat: &firewallpolicies.FirewallPolicySNAT{
+			AutoLearnPrivateRanges: &autoLearnPrivateRanges,
+		},
+		Sql: &firewallpolicies.FirewallPolicySQL{
+			AllowSqlRedirect: utils.Bool(d.Get("sql_redirect_allowed").(bool)),
+		},
+	}
+
+	if v, ok := d.GetOk("base_policy_id"); ok {
+		output.BasePolicy = &firewallpolicies.SubResource{
+			Id: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("private_ip_ranges"); ok {
+		output.Snat.PrivateRanges = utils.ExpandStringSlice(v.([]interface{}))
+	}
+
+	return output
+}
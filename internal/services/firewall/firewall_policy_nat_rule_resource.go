@@ -0,0 +1,19 @@
+package firewall
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func resourceFirewallPolicyNatRule() *pluginsdk.Resource {
+	return firewallPolicyRuleChildResource(firewallPolicyRuleChildResourceType{
+		resourceType: "azurerm_firewall_policy_nat_rule",
+		ruleType:     network.RuleTypeNatRule,
+		description:  "a NAT Rule",
+		schema:       firewallPolicyNatRuleSchema,
+		// see the `nat_rule_collection` block of the `azurerm_firewall_policy_rule_collection_group` resource for why `Dnat` is used
+		defaultAction: "Dnat",
+		expand:        expandFirewallPolicyRuleNat,
+		flatten:       flattenFirewallPolicyRuleNat,
+	})
+}
@@ -0,0 +1,93 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyNatRuleResource struct{}
+
+func TestAccFirewallPolicyNatRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_nat_rule", "test")
+	r := FirewallPolicyNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNatRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_nat_rule", "test")
+	r := FirewallPolicyNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.translatedFqdn(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FirewallPolicyNatRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleExists(ctx, clients, state)
+}
+
+func (FirewallPolicyNatRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_nat_rule" "test" {
+  name                     = "nat_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "nat_rule_collection1"
+  rule_collection_priority = 300
+  protocols                = ["TCP", "UDP"]
+  source_addresses         = ["10.0.0.1", "10.0.0.2"]
+  destination_address      = "192.168.1.1"
+  destination_ports        = ["80"]
+  translated_address       = "192.168.0.1"
+  translated_port          = 8080
+}
+`, FirewallPolicyNetworkRuleResource{}.template(data))
+}
+
+func (FirewallPolicyNatRuleResource) translatedFqdn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_nat_rule" "test" {
+  name                     = "nat_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "nat_rule_collection1"
+  rule_collection_priority = 350
+  protocols                = ["TCP"]
+  source_addresses         = ["10.0.0.1"]
+  destination_address      = "192.168.1.1"
+  destination_ports        = ["443"]
+  translated_fqdn          = "time.microsoft.com"
+  translated_port          = 8443
+}
+`, FirewallPolicyNetworkRuleResource{}.template(data))
+}
@@ -0,0 +1,23 @@
+package firewall
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func resourceFirewallPolicyNetworkRule() *pluginsdk.Resource {
+	return firewallPolicyRuleChildResource(firewallPolicyRuleChildResourceType{
+		resourceType: "azurerm_firewall_policy_network_rule",
+		ruleType:     network.RuleTypeNetworkRule,
+		description:  "a Network Rule",
+		schema:       firewallPolicyNetworkRuleSchema,
+		actions: []string{
+			string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
+			string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
+		},
+		expand: func(input []interface{}) (*[]network.BasicFirewallPolicyRule, error) {
+			return expandFirewallPolicyRuleNetwork(input), nil
+		},
+		flatten: flattenFirewallPolicyRuleNetwork,
+	})
+}
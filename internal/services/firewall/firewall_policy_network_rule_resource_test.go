@@ -0,0 +1,333 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FirewallPolicyNetworkRuleResource struct{}
+
+func TestAccFirewallPolicyNetworkRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.multipleRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_network_rule.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRule_mismatchedRuleCollectionPriority(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.mismatchedRuleCollectionPriority(data),
+			ExpectError: regexp.MustCompile("doesn't match the `rule_collection_priority`"),
+		},
+	})
+}
+
+func TestAccFirewallPolicyNetworkRule_ignoredByRuleCollectionGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ignoredByRuleCollectionGroup(data, 500),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Rule Collection Group mustn't remove the Rule Collection managed by the rule resource
+			Config: r.ignoredByRuleCollectionGroup(data, 600),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FirewallPolicyNetworkRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleExists(ctx, clients, state)
+}
+
+// firewallPolicyRuleExists determines whether the Application, NAT or Network Rule exists within the Rule Collection Group
+func firewallPolicyRuleExists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Firewall.FirewallPolicyRuleGroupClient.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
+	}
+
+	if resp.FirewallPolicyRuleCollectionGroupProperties == nil || resp.RuleCollections == nil {
+		return utils.Bool(false), nil
+	}
+
+	for _, collection := range *resp.RuleCollections {
+		if v, ok := collection.AsFirewallPolicyFilterRuleCollection(); ok && v.Name != nil && strings.EqualFold(*v.Name, id.RuleCollectionName) && v.Rules != nil {
+			for _, rule := range *v.Rules {
+				if r, ok := rule.AsRule(); ok && r.Name != nil && strings.EqualFold(*r.Name, id.RuleName) {
+					return utils.Bool(true), nil
+				}
+				if r, ok := rule.AsApplicationRule(); ok && r.Name != nil && strings.EqualFold(*r.Name, id.RuleName) {
+					return utils.Bool(true), nil
+				}
+			}
+		}
+
+		if v, ok := collection.AsFirewallPolicyNatRuleCollection(); ok && v.Name != nil && strings.EqualFold(*v.Name, id.RuleCollectionName) && v.Rules != nil {
+			for _, rule := range *v.Rules {
+				if r, ok := rule.AsNatRule(); ok && r.Name != nil && strings.EqualFold(*r.Name, id.RuleName) {
+					return utils.Bool(true), nil
+				}
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (FirewallPolicyNetworkRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-rule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-rule-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyNetworkRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                     = "network_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+  rule_collection_priority = 400
+  rule_collection_action   = "Deny"
+  protocols                = ["TCP", "UDP"]
+  source_addresses         = ["10.0.0.1"]
+  destination_addresses    = ["192.168.1.1", "192.168.1.2"]
+  destination_ports        = ["80", "1000-2000"]
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyNetworkRuleResource) multipleRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                     = "network_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+  rule_collection_priority = 400
+  rule_collection_action   = "Deny"
+  protocols                = ["TCP"]
+  source_addresses         = ["10.0.0.1", "10.0.0.2"]
+  destination_fqdns        = ["pluginsdk.io"]
+  destination_ports        = ["443"]
+}
+
+resource "azurerm_firewall_policy_network_rule" "second" {
+  name                     = "network_rule2"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+  rule_collection_priority = 400
+  rule_collection_action   = "Deny"
+  protocols                = ["UDP"]
+  source_addresses         = ["10.0.0.3"]
+  destination_addresses    = ["192.168.1.1"]
+  destination_ports        = ["53"]
+
+  depends_on = [azurerm_firewall_policy_network_rule.test]
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyNetworkRuleResource) mismatchedRuleCollectionPriority(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                     = "network_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+  rule_collection_priority = 400
+  rule_collection_action   = "Deny"
+  protocols                = ["TCP"]
+  source_addresses         = ["10.0.0.1"]
+  destination_fqdns        = ["pluginsdk.io"]
+  destination_ports        = ["443"]
+}
+
+resource "azurerm_firewall_policy_network_rule" "second" {
+  name                     = "network_rule2"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+  rule_collection_priority = 500
+  rule_collection_action   = "Deny"
+  protocols                = ["UDP"]
+  source_addresses         = ["10.0.0.3"]
+  destination_addresses    = ["192.168.1.1"]
+  destination_ports        = ["53"]
+
+  depends_on = [azurerm_firewall_policy_network_rule.test]
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyNetworkRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule" "import" {
+  name                     = azurerm_firewall_policy_network_rule.test.name
+  rule_collection_group_id = azurerm_firewall_policy_network_rule.test.rule_collection_group_id
+  rule_collection_name     = azurerm_firewall_policy_network_rule.test.rule_collection_name
+  rule_collection_priority = azurerm_firewall_policy_network_rule.test.rule_collection_priority
+  rule_collection_action   = azurerm_firewall_policy_network_rule.test.rule_collection_action
+  protocols                = azurerm_firewall_policy_network_rule.test.protocols
+  source_addresses         = azurerm_firewall_policy_network_rule.test.source_addresses
+  destination_addresses    = azurerm_firewall_policy_network_rule.test.destination_addresses
+  destination_ports        = azurerm_firewall_policy_network_rule.test.destination_ports
+}
+`, r.basic(data))
+}
+
+func (FirewallPolicyNetworkRuleResource) ignoredByRuleCollectionGroup(data acceptance.TestData, priority int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-rule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-rule-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name                            = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id              = azurerm_firewall_policy.test.id
+  priority                        = %[3]d
+  ignore_external_child_resources = true
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Allow"
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1"]
+      destination_ports     = ["80"]
+    }
+  }
+}
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                     = "network_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection2"
+  rule_collection_priority = 500
+  rule_collection_action   = "Deny"
+  protocols                = ["TCP", "UDP"]
+  source_addresses         = ["10.0.0.1"]
+  destination_addresses    = ["192.168.1.1", "192.168.1.2"]
+  destination_ports        = ["80", "1000-2000"]
+}
+`, data.RandomInteger, data.Locations.Primary, priority)
+}
@@ -5,7 +5,6 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
@@ -17,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-11-01/firewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
 	logAnalytiscValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -58,65 +58,77 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := firewallpolicies.NewFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(resp.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for existing %s: %+v", id, err)
 			}
 		}
 
-		if resp.ID != nil && *resp.ID != "" {
-			return tf.ImportAsExistsError("azurerm_firewall_policy", *resp.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_firewall_policy", id.ID())
 		}
 	}
 
-	expandedIdentity, err := expandFirewallPolicyIdentity(d.Get("identity").([]interface{}))
+	expandedIdentity, err := identity.ExpandUserAssignedMap(d.Get("identity").([]interface{}))
 	if err != nil {
 		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
-	props := network.FirewallPolicy{
-		FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
-			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
+
+	threatIntelMode := firewallpolicies.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string))
+	autoLearnPrivateRanges := firewallpolicies.AutoLearnPrivateRangesModeDisabled
+	if d.Get("auto_learn_private_ranges_enabled").(bool) {
+		autoLearnPrivateRanges = firewallpolicies.AutoLearnPrivateRangesModeEnabled
+	}
+
+	policyTags := tags.ToTypedObject(tags.Expand(d.Get("tags").(map[string]interface{})))
+	payload := firewallpolicies.FirewallPolicy{
+		Properties: &firewallpolicies.FirewallPolicyPropertiesFormat{
+			ThreatIntelMode:      &threatIntelMode,
 			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
-			DNSSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+			DnsSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
 			IntrusionDetection:   expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
 			TransportSecurity:    expandFirewallPolicyTransportSecurity(d.Get("tls_certificate").([]interface{})),
 			Insights:             expandFirewallPolicyInsights(d.Get("insights").([]interface{})),
+			ExplicitProxy:        expandFirewallPolicyExplicitProxy(d.Get("explicit_proxy").([]interface{})),
+			Snat: &firewallpolicies.FirewallPolicySNAT{
+				AutoLearnPrivateRanges: &autoLearnPrivateRanges,
+			},
+			Sql: &firewallpolicies.FirewallPolicySQL{
+				AllowSqlRedirect: utils.Bool(d.Get("sql_redirect_allowed").(bool)),
+			},
 		},
 		Identity: expandedIdentity,
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     &policyTags,
 	}
-	if id, ok := d.GetOk("base_policy_id"); ok {
-		props.FirewallPolicyPropertiesFormat.BasePolicy = &network.SubResource{ID: utils.String(id.(string))}
+
+	if v, ok := d.GetOk("base_policy_id"); ok {
+		payload.Properties.BasePolicy = &firewallpolicies.SubResource{
+			Id: utils.String(v.(string)),
+		}
 	}
 
 	if v, ok := d.GetOk("sku"); ok {
-		props.FirewallPolicyPropertiesFormat.Sku = &network.FirewallPolicySku{
-			Tier: network.FirewallPolicySkuTier(v.(string)),
+		tier := firewallpolicies.FirewallPolicySkuTier(v.(string))
+		payload.Properties.Sku = &firewallpolicies.FirewallPolicySku{
+			Tier: &tier,
 		}
 	}
 
 	if v, ok := d.GetOk("private_ip_ranges"); ok {
-		privateIPRanges := utils.ExpandStringSlice(v.([]interface{}))
-		props.FirewallPolicyPropertiesFormat.Snat = &network.FirewallPolicySNAT{
-			PrivateRanges: privateIPRanges,
-		}
+		payload.Properties.Snat.PrivateRanges = utils.ExpandStringSlice(v.([]interface{}))
 	}
 
-	locks.ByName(id.Name, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(id.Name, azureFirewallPolicyResourceName)
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, props)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creating/updating %s: %+v", id, err)
-	}
 
 	d.SetId(id.ID())
 
@@ -128,89 +140,117 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.FirewallPolicyID(d.Id())
+	id, err := firewallpolicies.ParseFirewallPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[DEBUG] Firewall Policy %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("retrieving Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("name", id.FirewallPolicyName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if prop := resp.FirewallPolicyPropertiesFormat; prop != nil {
-		basePolicyID := ""
-		if resp.BasePolicy != nil && resp.BasePolicy.ID != nil {
-			basePolicyID = *resp.BasePolicy.ID
-		}
-		d.Set("base_policy_id", basePolicyID)
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
 
-		d.Set("threat_intelligence_mode", string(prop.ThreatIntelMode))
+		if prop := model.Properties; prop != nil {
+			basePolicyID := ""
+			if prop.BasePolicy != nil && prop.BasePolicy.Id != nil {
+				basePolicyID = *prop.BasePolicy.Id
+			}
+			d.Set("base_policy_id", basePolicyID)
 
-		if sku := prop.Sku; sku != nil {
-			d.Set("sku", string(sku.Tier))
-		}
+			threatIntelMode := ""
+			if prop.ThreatIntelMode != nil {
+				threatIntelMode = string(*prop.ThreatIntelMode)
+			}
+			d.Set("threat_intelligence_mode", threatIntelMode)
 
-		if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(resp.ThreatIntelWhitelist)); err != nil {
-			return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
-		}
+			if sku := prop.Sku; sku != nil && sku.Tier != nil {
+				d.Set("sku", string(*sku.Tier))
+			}
 
-		if err := d.Set("dns", flattenFirewallPolicyDNSSetting(prop.DNSSettings)); err != nil {
-			return fmt.Errorf(`setting "dns": %+v`, err)
-		}
+			if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(prop.ThreatIntelWhitelist)); err != nil {
+				return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
+			}
 
-		if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(resp.IntrusionDetection)); err != nil {
-			return fmt.Errorf(`setting "intrusion_detection": %+v`, err)
-		}
+			if err := d.Set("dns", flattenFirewallPolicyDNSSettingWithDeprecatedProperties(prop.DnsSettings)); err != nil {
+				return fmt.Errorf(`setting "dns": %+v`, err)
+			}
 
-		if err := d.Set("tls_certificate", flattenFirewallPolicyTransportSecurity(prop.TransportSecurity)); err != nil {
-			return fmt.Errorf(`setting "tls_certificate": %+v`, err)
-		}
+			if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(prop.IntrusionDetection)); err != nil {
+				return fmt.Errorf(`setting "intrusion_detection": %+v`, err)
+			}
 
-		if err := d.Set("child_policies", flattenNetworkSubResourceID(prop.ChildPolicies)); err != nil {
-			return fmt.Errorf(`setting "child_policies": %+v`, err)
-		}
+			if err := d.Set("tls_certificate", flattenFirewallPolicyTransportSecurity(prop.TransportSecurity)); err != nil {
+				return fmt.Errorf(`setting "tls_certificate": %+v`, err)
+			}
 
-		if err := d.Set("firewalls", flattenNetworkSubResourceID(prop.Firewalls)); err != nil {
-			return fmt.Errorf(`setting "firewalls": %+v`, err)
-		}
+			if err := d.Set("child_policies", flattenFirewallPolicySubResourceIds(prop.ChildPolicies)); err != nil {
+				return fmt.Errorf(`setting "child_policies": %+v`, err)
+			}
 
-		if err := d.Set("rule_collection_groups", flattenNetworkSubResourceID(prop.RuleCollectionGroups)); err != nil {
-			return fmt.Errorf(`setting "rule_collection_groups": %+v`, err)
+			if err := d.Set("firewalls", flattenFirewallPolicySubResourceIds(prop.Firewalls)); err != nil {
+				return fmt.Errorf(`setting "firewalls": %+v`, err)
+			}
+
+			if err := d.Set("rule_collection_groups", flattenFirewallPolicySubResourceIds(prop.RuleCollectionGroups)); err != nil {
+				return fmt.Errorf(`setting "rule_collection_groups": %+v`, err)
+			}
+
+			var privateIPRanges []interface{}
+			autoLearnPrivateRangesEnabled := false
+			if prop.Snat != nil {
+				privateIPRanges = utils.FlattenStringSlice(prop.Snat.PrivateRanges)
+				autoLearnPrivateRangesEnabled = prop.Snat.AutoLearnPrivateRanges != nil && *prop.Snat.AutoLearnPrivateRanges == firewallpolicies.AutoLearnPrivateRangesModeEnabled
+			}
+			if err := d.Set("private_ip_ranges", privateIPRanges); err != nil {
+				return fmt.Errorf("setting `private_ip_ranges`: %+v", err)
+			}
+			d.Set("auto_learn_private_ranges_enabled", autoLearnPrivateRangesEnabled)
+
+			if err := d.Set("insights", flattenFirewallPolicyInsights(prop.Insights)); err != nil {
+				return fmt.Errorf(`setting "insights": %+v`, err)
+			}
+
+			if err := d.Set("explicit_proxy", flattenFirewallPolicyExplicitProxy(prop.ExplicitProxy)); err != nil {
+				return fmt.Errorf(`setting "explicit_proxy": %+v`, err)
+			}
+
+			sqlRedirectAllowed := false
+			if prop.Sql != nil && prop.Sql.AllowSqlRedirect != nil {
+				sqlRedirectAllowed = *prop.Sql.AllowSqlRedirect
+			}
+			d.Set("sql_redirect_allowed", sqlRedirectAllowed)
 		}
 
-		var privateIPRanges []interface{}
-		if prop.Snat != nil {
-			privateIPRanges = utils.FlattenStringSlice(prop.Snat.PrivateRanges)
+		flattenedIdentity, err := identity.FlattenUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
 		}
-		if err := d.Set("private_ip_ranges", privateIPRanges); err != nil {
-			return fmt.Errorf("setting `private_ip_ranges`: %+v", err)
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
 		}
 
-		if err := d.Set("insights", flattenFirewallPolicyInsights(prop.Insights)); err != nil {
-			return fmt.Errorf(`setting "insights": %+v`, err)
+		var policyTags map[string]*string
+		if model.Tags != nil {
+			policyTags = tags.FromTypedObject(*model.Tags)
+		}
+		if err := tags.FlattenAndSet(d, policyTags); err != nil {
+			return err
 		}
 	}
 
-	flattenedIdentity, err := flattenFirewallPolicyIdentity(resp.Identity)
-	if err != nil {
-		return fmt.Errorf("flattening `identity`: %+v", err)
-	}
-	if err := d.Set("identity", flattenedIdentity); err != nil {
-		return fmt.Errorf("setting `identity`: %+v", err)
-	}
-
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -218,79 +258,115 @@ func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.FirewallPolicyID(d.Id())
+	id, err := firewallpolicies.ParseFirewallPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.Name, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(id.Name, azureFirewallPolicyResourceName)
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("deleting Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("waiting for deleting Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-		}
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
 }
 
-func expandFirewallPolicyThreatIntelWhitelist(input []interface{}) *network.FirewallPolicyThreatIntelWhitelist {
+func expandFirewallPolicyTransportSecurity(input []interface{}) *firewallpolicies.FirewallPolicyTransportSecurity {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
 	raw := input[0].(map[string]interface{})
-	output := &network.FirewallPolicyThreatIntelWhitelist{
+
+	return &firewallpolicies.FirewallPolicyTransportSecurity{
+		CertificateAuthority: &firewallpolicies.FirewallPolicyCertificateAuthority{
+			KeyVaultSecretId: utils.String(raw["key_vault_secret_id"].(string)),
+			Name:             utils.String(raw["name"].(string)),
+		},
+	}
+}
+
+func expandFirewallPolicyThreatIntelWhitelist(input []interface{}) *firewallpolicies.FirewallPolicyThreatIntelWhitelist {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &firewallpolicies.FirewallPolicyThreatIntelWhitelist{
 		IPAddresses: utils.ExpandStringSlice(raw["ip_addresses"].(*pluginsdk.Set).List()),
 		Fqdns:       utils.ExpandStringSlice(raw["fqdns"].(*pluginsdk.Set).List()),
 	}
-
-	return output
 }
 
-func expandFirewallPolicyDNSSetting(input []interface{}) *network.DNSSettings {
+func expandFirewallPolicyDNSSetting(input []interface{}) *firewallpolicies.DnsSettings {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
 	raw := input[0].(map[string]interface{})
-	output := &network.DNSSettings{
+	return &firewallpolicies.DnsSettings{
 		Servers:     utils.ExpandStringSlice(raw["servers"].(*pluginsdk.Set).List()),
 		EnableProxy: utils.Bool(raw["proxy_enabled"].(bool)),
 	}
+}
+
+func expandFirewallPolicyExplicitProxy(input []interface{}) *firewallpolicies.ExplicitProxy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &firewallpolicies.ExplicitProxy{
+		EnableExplicitProxy: utils.Bool(raw["enabled"].(bool)),
+		EnablePacFile:       utils.Bool(raw["pac_file"].(string) != ""),
+	}
+
+	if v := raw["http_port"].(int); v != 0 {
+		output.HTTPPort = utils.Int64(int64(v))
+	}
+
+	if v := raw["https_port"].(int); v != 0 {
+		output.HTTPSPort = utils.Int64(int64(v))
+	}
+
+	if v := raw["pac_file_port"].(int); v != 0 {
+		output.PacFilePort = utils.Int64(int64(v))
+	}
+
+	if v := raw["pac_file"].(string); v != "" {
+		output.PacFile = utils.String(v)
+	}
 
 	return output
 }
 
-func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.FirewallPolicyIntrusionDetection {
+func expandFirewallPolicyIntrusionDetection(input []interface{}) *firewallpolicies.FirewallPolicyIntrusionDetection {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
 	raw := input[0].(map[string]interface{})
 
-	var signatureOverrides []network.FirewallPolicyIntrusionDetectionSignatureSpecification
+	signatureOverrides := make([]firewallpolicies.FirewallPolicyIntrusionDetectionSignatureSpecification, 0)
 	for _, v := range raw["signature_overrides"].([]interface{}) {
 		overrides := v.(map[string]interface{})
-		signatureOverrides = append(signatureOverrides, network.FirewallPolicyIntrusionDetectionSignatureSpecification{
-			ID:   utils.String(overrides["id"].(string)),
-			Mode: network.FirewallPolicyIntrusionDetectionStateType(overrides["state"].(string)),
+		mode := firewallpolicies.FirewallPolicyIntrusionDetectionStateType(overrides["state"].(string))
+		signatureOverrides = append(signatureOverrides, firewallpolicies.FirewallPolicyIntrusionDetectionSignatureSpecification{
+			Id:   utils.String(overrides["id"].(string)),
+			Mode: &mode,
 		})
 	}
 
-	var trafficBypass []network.FirewallPolicyIntrusionDetectionBypassTrafficSpecifications
-
+	trafficBypass := make([]firewallpolicies.FirewallPolicyIntrusionDetectionBypassTrafficSpecifications, 0)
 	for _, v := range raw["traffic_bypass"].([]interface{}) {
 		bypass := v.(map[string]interface{})
-		trafficBypass = append(trafficBypass, network.FirewallPolicyIntrusionDetectionBypassTrafficSpecifications{
+		protocol := firewallpolicies.FirewallPolicyIntrusionDetectionProtocol(bypass["protocol"].(string))
+		trafficBypass = append(trafficBypass, firewallpolicies.FirewallPolicyIntrusionDetectionBypassTrafficSpecifications{
 			Name:                 utils.String(bypass["name"].(string)),
 			Description:          utils.String(bypass["description"].(string)),
-			Protocol:             network.FirewallPolicyIntrusionDetectionProtocol(bypass["protocol"].(string)),
+			Protocol:             &protocol,
 			SourceAddresses:      utils.ExpandStringSlice(bypass["source_addresses"].(*pluginsdk.Set).List()),
 			DestinationAddresses: utils.ExpandStringSlice(bypass["destination_addresses"].(*pluginsdk.Set).List()),
 			DestinationPorts:     utils.ExpandStringSlice(bypass["destination_ports"].(*pluginsdk.Set).List()),
@@ -299,97 +375,52 @@ func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.Firewa
 		})
 	}
 
-	return &network.FirewallPolicyIntrusionDetection{
-		Mode: network.FirewallPolicyIntrusionDetectionStateType(raw["mode"].(string)),
-		Configuration: &network.FirewallPolicyIntrusionDetectionConfiguration{
+	output := &firewallpolicies.FirewallPolicyIntrusionDetection{
+		Configuration: &firewallpolicies.FirewallPolicyIntrusionDetectionConfiguration{
 			SignatureOverrides:    &signatureOverrides,
 			BypassTrafficSettings: &trafficBypass,
 		},
 	}
-}
-
-func expandFirewallPolicyTransportSecurity(input []interface{}) *network.FirewallPolicyTransportSecurity {
-	if len(input) == 0 || input[0] == nil {
-		return nil
-	}
-
-	raw := input[0].(map[string]interface{})
-
-	return &network.FirewallPolicyTransportSecurity{
-		CertificateAuthority: &network.FirewallPolicyCertificateAuthority{
-			KeyVaultSecretID: utils.String(raw["key_vault_secret_id"].(string)),
-			Name:             utils.String(raw["name"].(string)),
-		},
-	}
-}
-
-func expandFirewallPolicyIdentity(input []interface{}) (*network.ManagedServiceIdentity, error) {
-	expanded, err := identity.ExpandUserAssignedMap(input)
-	if err != nil {
-		return nil, err
-	}
 
-	if expanded.Type == identity.TypeNone {
-		return nil, nil
+	if v := raw["mode"].(string); v != "" {
+		mode := firewallpolicies.FirewallPolicyIntrusionDetectionStateType(v)
+		output.Mode = &mode
 	}
 
-	out := network.ManagedServiceIdentity{
-		PrincipalID:            nil,
-		TenantID:               nil,
-		Type:                   network.ResourceIdentityType(string(expanded.Type)),
-		UserAssignedIdentities: nil,
-	}
-	if expanded.Type == identity.TypeUserAssigned {
-		out.UserAssignedIdentities = make(map[string]*network.ManagedServiceIdentityUserAssignedIdentitiesValue)
-		for k := range expanded.IdentityIds {
-			out.UserAssignedIdentities[k] = &network.ManagedServiceIdentityUserAssignedIdentitiesValue{
-				// intentionally empty
-			}
-		}
-	}
-	return &out, nil
+	return output
 }
 
-func expandFirewallPolicyInsights(input []interface{}) *network.FirewallPolicyInsights {
+func expandFirewallPolicyInsights(input []interface{}) *firewallpolicies.FirewallPolicyInsights {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
 	raw := input[0].(map[string]interface{})
-	output := &network.FirewallPolicyInsights{
-		IsEnabled:             utils.Bool(raw["enabled"].(bool)),
-		RetentionDays:         utils.Int32(int32(raw["retention_in_days"].(int))),
-		LogAnalyticsResources: expandFirewallPolicyLogAnalyticsResources(raw["default_log_analytics_workspace_id"].(string), raw["log_analytics_workspace"].([]interface{})),
-	}
-
-	return output
-}
-
-func expandFirewallPolicyLogAnalyticsResources(defaultWorkspaceId string, workspaces []interface{}) *network.FirewallPolicyLogAnalyticsResources {
-	output := &network.FirewallPolicyLogAnalyticsResources{
-		DefaultWorkspaceID: &network.SubResource{
-			ID: &defaultWorkspaceId,
-		},
-	}
 
-	var workspaceList []network.FirewallPolicyLogAnalyticsWorkspace
-	for _, workspace := range workspaces {
-		workspace := workspace.(map[string]interface{})
-		workspaceList = append(workspaceList, network.FirewallPolicyLogAnalyticsWorkspace{
+	workspaces := make([]firewallpolicies.FirewallPolicyLogAnalyticsWorkspace, 0)
+	for _, v := range raw["log_analytics_workspace"].([]interface{}) {
+		workspace := v.(map[string]interface{})
+		workspaces = append(workspaces, firewallpolicies.FirewallPolicyLogAnalyticsWorkspace{
 			Region: utils.String(location.Normalize(workspace["firewall_location"].(string))),
-			WorkspaceID: &network.SubResource{
-				ID: utils.String(workspace["id"].(string)),
+			WorkspaceId: &firewallpolicies.SubResource{
+				Id: utils.String(workspace["id"].(string)),
 			},
 		})
 	}
-	if workspaceList != nil {
-		output.Workspaces = &workspaceList
-	}
 
-	return output
+	return &firewallpolicies.FirewallPolicyInsights{
+		IsEnabled:     utils.Bool(raw["enabled"].(bool)),
+		RetentionDays: utils.Int64(int64(raw["retention_in_days"].(int))),
+		LogAnalyticsResources: &firewallpolicies.FirewallPolicyLogAnalyticsResources{
+			DefaultWorkspaceId: &firewallpolicies.SubResource{
+				Id: utils.String(raw["default_log_analytics_workspace_id"].(string)),
+			},
+			Workspaces: &workspaces,
+		},
+	}
 }
 
-func flattenFirewallPolicyThreatIntelWhitelist(input *network.FirewallPolicyThreatIntelWhitelist) []interface{} {
+func flattenFirewallPolicyThreatIntelWhitelist(input *firewallpolicies.FirewallPolicyThreatIntelWhitelist) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -402,7 +433,7 @@ func flattenFirewallPolicyThreatIntelWhitelist(input *network.FirewallPolicyThre
 	}
 }
 
-func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
+func flattenFirewallPolicyDNSSetting(input *firewallpolicies.DnsSettings) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -412,193 +443,212 @@ func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
 		proxyEnabled = *input.EnableProxy
 	}
 
-	out := map[string]interface{}{
-		"servers":       utils.FlattenStringSlice(input.Servers),
-		"proxy_enabled": proxyEnabled,
-	}
-	if !features.ThreePointOhBeta() {
-		out["network_rule_fqdn_enabled"] = false
+	return []interface{}{
+		map[string]interface{}{
+			"servers":       utils.FlattenStringSlice(input.Servers),
+			"proxy_enabled": proxyEnabled,
+		},
 	}
-	return []interface{}{out}
 }
 
-func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrusionDetection) []interface{} {
+func flattenFirewallPolicyExplicitProxy(input *firewallpolicies.ExplicitProxy) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
-	signatureOverrides := make([]interface{}, 0)
-	trafficBypass := make([]interface{}, 0)
-
-	if input.Configuration == nil {
-		return []interface{}{
-			map[string]interface{}{
-				"mode":                string(input.Mode),
-				"signature_overrides": signatureOverrides,
-				"traffic_bypass":      trafficBypass,
-			},
-		}
+	enabled := false
+	if input.EnableExplicitProxy != nil {
+		enabled = *input.EnableExplicitProxy
 	}
 
-	if overrides := input.Configuration.SignatureOverrides; overrides != nil {
-		for _, override := range *overrides {
-			id := ""
-			if override.ID != nil {
-				id = *override.ID
-			}
-			signatureOverrides = append(signatureOverrides, map[string]interface{}{
-				"id":    id,
-				"state": string(override.Mode),
-			})
-		}
+	httpPort := 0
+	if input.HTTPPort != nil {
+		httpPort = int(*input.HTTPPort)
 	}
 
-	if bypasses := input.Configuration.BypassTrafficSettings; bypasses != nil {
-		for _, bypass := range *bypasses {
-			name := ""
-			if bypass.Name != nil {
-				name = *bypass.Name
-			}
-
-			description := ""
-			if bypass.Description != nil {
-				description = *bypass.Description
-			}
-
-			sourceAddresses := make([]string, 0)
-			if bypass.SourceAddresses != nil {
-				sourceAddresses = *bypass.SourceAddresses
-			}
-
-			destinationAddresses := make([]string, 0)
-			if bypass.DestinationAddresses != nil {
-				destinationAddresses = *bypass.DestinationAddresses
-			}
-
-			destinationPorts := make([]string, 0)
-			if bypass.DestinationPorts != nil {
-				destinationPorts = *bypass.DestinationPorts
-			}
-
-			sourceIPGroups := make([]string, 0)
-			if bypass.SourceIPGroups != nil {
-				sourceIPGroups = *bypass.SourceIPGroups
-			}
+	httpsPort := 0
+	if input.HTTPSPort != nil {
+		httpsPort = int(*input.HTTPSPort)
+	}
 
-			destinationIPGroups := make([]string, 0)
-			if bypass.DestinationIPGroups != nil {
-				destinationIPGroups = *bypass.DestinationIPGroups
-			}
+	pacFilePort := 0
+	if input.PacFilePort != nil {
+		pacFilePort = int(*input.PacFilePort)
+	}
 
-			trafficBypass = append(trafficBypass, map[string]interface{}{
-				"name":                  name,
-				"description":           description,
-				"protocol":              string(bypass.Protocol),
-				"source_addresses":      sourceAddresses,
-				"destination_addresses": destinationAddresses,
-				"destination_ports":     destinationPorts,
-				"source_ip_groups":      sourceIPGroups,
-				"destination_ip_groups": destinationIPGroups,
-			})
-		}
+	pacFile := ""
+	if input.PacFile != nil {
+		pacFile = *input.PacFile
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"mode":                string(input.Mode),
-			"signature_overrides": signatureOverrides,
-			"traffic_bypass":      trafficBypass,
+			"enabled":       enabled,
+			"http_port":     httpPort,
+			"https_port":    httpsPort,
+			"pac_file_port": pacFilePort,
+			"pac_file":      pacFile,
 		},
 	}
 }
 
-func flattenFirewallPolicyTransportSecurity(input *network.FirewallPolicyTransportSecurity) []interface{} {
-	if input == nil || input.CertificateAuthority == nil {
+func flattenFirewallPolicyIntrusionDetection(input *firewallpolicies.FirewallPolicyIntrusionDetection) []interface{} {
+	if input == nil {
 		return []interface{}{}
 	}
 
-	return []interface{}{
-		map[string]interface{}{
-			"key_vault_secret_id": input.CertificateAuthority.KeyVaultSecretID,
-			"name":                input.CertificateAuthority.Name,
-		},
+	mode := ""
+	if input.Mode != nil {
+		mode = string(*input.Mode)
 	}
-}
 
-func flattenFirewallPolicyIdentity(input *network.ManagedServiceIdentity) (*[]interface{}, error) {
-	var transition *identity.UserAssignedMap
-
-	if input != nil {
-		transition = &identity.UserAssignedMap{
-			Type:        identity.Type(string(input.Type)),
-			IdentityIds: make(map[string]identity.UserAssignedIdentityDetails),
+	signatureOverrides := make([]interface{}, 0)
+	trafficBypass := make([]interface{}, 0)
+	if config := input.Configuration; config != nil {
+		if config.SignatureOverrides != nil {
+			for _, override := range *config.SignatureOverrides {
+				id := ""
+				if override.Id != nil {
+					id = *override.Id
+				}
+
+				state := ""
+				if override.Mode != nil {
+					state = string(*override.Mode)
+				}
+
+				signatureOverrides = append(signatureOverrides, map[string]interface{}{
+					"id":    id,
+					"state": state,
+				})
+			}
 		}
-		for k, v := range input.UserAssignedIdentities {
-			transition.IdentityIds[k] = identity.UserAssignedIdentityDetails{
-				ClientId:    v.ClientID,
-				PrincipalId: v.PrincipalID,
+
+		if config.BypassTrafficSettings != nil {
+			for _, bypass := range *config.BypassTrafficSettings {
+				name := ""
+				if bypass.Name != nil {
+					name = *bypass.Name
+				}
+
+				description := ""
+				if bypass.Description != nil {
+					description = *bypass.Description
+				}
+
+				protocol := ""
+				if bypass.Protocol != nil {
+					protocol = string(*bypass.Protocol)
+				}
+
+				trafficBypass = append(trafficBypass, map[string]interface{}{
+					"name":                  name,
+					"description":           description,
+					"protocol":              protocol,
+					"source_addresses":      utils.FlattenStringSlice(bypass.SourceAddresses),
+					"destination_addresses": utils.FlattenStringSlice(bypass.DestinationAddresses),
+					"destination_ports":     utils.FlattenStringSlice(bypass.DestinationPorts),
+					"source_ip_groups":      utils.FlattenStringSlice(bypass.SourceIPGroups),
+					"destination_ip_groups": utils.FlattenStringSlice(bypass.DestinationIPGroups),
+				})
 			}
 		}
 	}
 
-	return identity.FlattenUserAssignedMap(transition)
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                mode,
+			"signature_overrides": signatureOverrides,
+			"traffic_bypass":      trafficBypass,
+		},
+	}
 }
 
-func flattenFirewallPolicyInsights(input *network.FirewallPolicyInsights) []interface{} {
+func flattenFirewallPolicyInsights(input *firewallpolicies.FirewallPolicyInsights) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
-	var enabled bool
+	enabled := false
 	if input.IsEnabled != nil {
 		enabled = *input.IsEnabled
 	}
 
-	var retentionInDays int
+	retentionInDays := 0
 	if input.RetentionDays != nil {
 		retentionInDays = int(*input.RetentionDays)
 	}
 
-	defaultLogAnalyticsWorspaceId, logAnalyticsWorkspaces := flattenFirewallPolicyLogAnalyticsResources(input.LogAnalyticsResources)
+	defaultWorkspaceId := ""
+	workspaces := make([]interface{}, 0)
+	if resources := input.LogAnalyticsResources; resources != nil {
+		if resources.DefaultWorkspaceId != nil && resources.DefaultWorkspaceId.Id != nil {
+			defaultWorkspaceId = *resources.DefaultWorkspaceId.Id
+		}
+
+		if resources.Workspaces != nil {
+			for _, workspace := range *resources.Workspaces {
+				id := ""
+				if workspace.WorkspaceId != nil && workspace.WorkspaceId.Id != nil {
+					id = *workspace.WorkspaceId.Id
+				}
+
+				workspaces = append(workspaces, map[string]interface{}{
+					"id":                id,
+					"firewall_location": location.NormalizeNilable(workspace.Region),
+				})
+			}
+		}
+	}
 
 	return []interface{}{
 		map[string]interface{}{
 			"enabled":                            enabled,
 			"retention_in_days":                  retentionInDays,
-			"default_log_analytics_workspace_id": defaultLogAnalyticsWorspaceId,
-			"log_analytics_workspace":            logAnalyticsWorkspaces,
+			"default_log_analytics_workspace_id": defaultWorkspaceId,
+			"log_analytics_workspace":            workspaces,
 		},
 	}
 }
 
-func flattenFirewallPolicyLogAnalyticsResources(input *network.FirewallPolicyLogAnalyticsResources) (string, []interface{}) {
-	if input == nil {
-		return "", []interface{}{}
+// flattenFirewallPolicyDNSSettingWithDeprecatedProperties flattens the DNS Settings including the deprecated
+// `network_rule_fqdn_enabled` property, which is only available in the schema of the Firewall Policy
+func flattenFirewallPolicyDNSSettingWithDeprecatedProperties(input *firewallpolicies.DnsSettings) []interface{} {
+	output := flattenFirewallPolicyDNSSetting(input)
+	if !features.ThreePointOhBeta() {
+		for _, v := range output {
+			v.(map[string]interface{})["network_rule_fqdn_enabled"] = false
+		}
 	}
+	return output
+}
 
-	var defaultLogAnalyticsWorkspaceId string
-	if input.DefaultWorkspaceID != nil && input.DefaultWorkspaceID.ID != nil {
-		defaultLogAnalyticsWorkspaceId = *input.DefaultWorkspaceID.ID
+func flattenFirewallPolicyTransportSecurity(input *firewallpolicies.FirewallPolicyTransportSecurity) []interface{} {
+	if input == nil || input.CertificateAuthority == nil {
+		return []interface{}{}
 	}
 
-	var workspaceList []interface{}
-	if input.Workspaces != nil {
-		for _, workspace := range *input.Workspaces {
-			loc := location.NormalizeNilable(workspace.Region)
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_secret_id": input.CertificateAuthority.KeyVaultSecretId,
+			"name":                input.CertificateAuthority.Name,
+		},
+	}
+}
 
-			var id string
-			if workspace.WorkspaceID != nil && workspace.WorkspaceID.ID != nil {
-				id = *workspace.WorkspaceID.ID
-			}
+func flattenFirewallPolicySubResourceIds(input *[]firewallpolicies.SubResource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
 
-			workspaceList = append(workspaceList, map[string]interface{}{
-				"id":                id,
-				"firewall_location": loc,
-			})
+	for _, item := range *input {
+		if item.Id != nil {
+			results = append(results, *item.Id)
 		}
 	}
 
-	return defaultLogAnalyticsWorkspaceId, workspaceList
+	return results
 }
 
 func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
@@ -618,8 +668,8 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 			Computed: true,
 			ForceNew: true,
 			ValidateFunc: validation.StringInSlice([]string{
-				string(network.FirewallPolicySkuTierPremium),
-				string(network.FirewallPolicySkuTierStandard),
+				string(firewallpolicies.FirewallPolicySkuTierPremium),
+				string(firewallpolicies.FirewallPolicySkuTierStandard),
 			}, false),
 		},
 
@@ -658,11 +708,11 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 		"threat_intelligence_mode": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
-			Default:  string(network.AzureFirewallThreatIntelModeAlert),
+			Default:  string(firewallpolicies.AzureFirewallThreatIntelModeAlert),
 			ValidateFunc: validation.StringInSlice([]string{
-				string(network.AzureFirewallThreatIntelModeAlert),
-				string(network.AzureFirewallThreatIntelModeDeny),
-				string(network.AzureFirewallThreatIntelModeOff),
+				string(firewallpolicies.AzureFirewallThreatIntelModeAlert),
+				string(firewallpolicies.AzureFirewallThreatIntelModeDeny),
+				string(firewallpolicies.AzureFirewallThreatIntelModeOff),
 			}, false),
 		},
 
@@ -704,9 +754,9 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 					"mode": {
 						Type: pluginsdk.TypeString,
 						ValidateFunc: validation.StringInSlice([]string{
-							string(network.FirewallPolicyIntrusionDetectionStateTypeOff),
-							string(network.FirewallPolicyIntrusionDetectionStateTypeAlert),
-							string(network.FirewallPolicyIntrusionDetectionStateTypeDeny),
+							string(firewallpolicies.FirewallPolicyIntrusionDetectionStateTypeOff),
+							string(firewallpolicies.FirewallPolicyIntrusionDetectionStateTypeAlert),
+							string(firewallpolicies.FirewallPolicyIntrusionDetectionStateTypeDeny),
 						}, false),
 						Optional: true,
 					},
@@ -718,9 +768,9 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 								"state": {
 									Type: pluginsdk.TypeString,
 									ValidateFunc: validation.StringInSlice([]string{
-										string(network.FirewallPolicyIntrusionDetectionStateTypeOff),
-										string(network.FirewallPolicyIntrusionDetectionStateTypeAlert),
-										string(network.FirewallPolicyIntrusionDetectionStateTypeDeny),
+										string(firewallpolicies.FirewallPolicyIntrusionDetectionStateTypeOff),
+										string(firewallpolicies.FirewallPolicyIntrusionDetectionStateTypeAlert),
+										string(firewallpolicies.FirewallPolicyIntrusionDetectionStateTypeDeny),
 									}, false),
 									Optional: true,
 								},
@@ -748,10 +798,10 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 									Type:     pluginsdk.TypeString,
 									Required: true,
 									ValidateFunc: validation.StringInSlice([]string{
-										string(network.FirewallPolicyIntrusionDetectionProtocolICMP),
-										string(network.FirewallPolicyIntrusionDetectionProtocolANY),
-										string(network.FirewallPolicyIntrusionDetectionProtocolTCP),
-										string(network.FirewallPolicyIntrusionDetectionProtocolUDP),
+										string(firewallpolicies.FirewallPolicyIntrusionDetectionProtocolICMP),
+										string(firewallpolicies.FirewallPolicyIntrusionDetectionProtocolANY),
+										string(firewallpolicies.FirewallPolicyIntrusionDetectionProtocolTCP),
+										string(firewallpolicies.FirewallPolicyIntrusionDetectionProtocolUDP),
 									}, !features.ThreePointOhBeta()),
 									DiffSuppressFunc: suppress.CaseDifferenceV2Only,
 								},
@@ -856,6 +906,47 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"explicit_proxy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+					"http_port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 64000),
+					},
+					"https_port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 64000),
+					},
+					"pac_file_port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 64000),
+					},
+					"pac_file": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						RequiredWith: []string{"explicit_proxy.0.pac_file_port"},
+					},
+				},
+			},
+		},
+
+		"sql_redirect_allowed": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"child_policies": {
 			Type:     pluginsdk.TypeList,
 			Computed: true,
@@ -893,6 +984,12 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"auto_learn_private_ranges_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"tags": tags.SchemaEnforceLowerCaseKeys(),
 	}
 
@@ -9,7 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-11-01/firewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -194,17 +194,17 @@ func TestAccFirewallPolicy_insights(t *testing.T) {
 }
 
 func (FirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := parse.FirewallPolicyID(state.ID)
+	id, err := firewallpolicies.ParseFirewallPolicyID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := clients.Firewall.FirewallPolicyClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Firewall.FirewallPolicyClient.Get(ctx, *id)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
 
-	return utils.Bool(resp.FirewallPolicyPropertiesFormat != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil), nil
 }
 
 func (FirewallPolicyResource) basic(data acceptance.TestData) string {
@@ -252,6 +252,13 @@ resource "azurerm_firewall_policy" "test" {
     servers       = ["1.1.1.1", "2.2.2.2"]
     proxy_enabled = true
   }
+  explicit_proxy {
+    enabled    = true
+    http_port  = 8087
+    https_port = 8088
+  }
+  sql_redirect_allowed              = true
+  auto_learn_private_ranges_enabled = true
   tags = {
     env = "Test"
   }
@@ -0,0 +1,659 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// firewallPolicyRuleChildResourceType describes a resource managing a single rule of a given type within one of the
+// Rule Collections of a Firewall Policy Rule Collection Group
+type firewallPolicyRuleChildResourceType struct {
+	// resourceType is the name of the Terraform resource, for example `azurerm_firewall_policy_network_rule`
+	resourceType string
+
+	// ruleType is the type of the rule managed by this resource
+	ruleType network.RuleType
+
+	// description is a human-readable name for the type of the rule, used in error messages
+	description string
+
+	// schema returns the schema for a single rule within the `azurerm_firewall_policy_rule_collection_group` resource
+	schema func() map[string]*pluginsdk.Schema
+
+	// actions are the possible values for the Rule Collection Action - when empty the Action isn't configurable and
+	// `defaultAction` is used instead
+	actions       []string
+	defaultAction string
+
+	expand  func(input []interface{}) (*[]network.BasicFirewallPolicyRule, error)
+	flatten func(input *[]network.BasicFirewallPolicyRule) ([]interface{}, error)
+}
+
+func firewallPolicyRuleChildResource(ruleType firewallPolicyRuleChildResourceType) *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: firewallPolicyRuleChildResourceCreateUpdate(ruleType),
+		Read:   firewallPolicyRuleChildResourceRead(ruleType),
+		Update: firewallPolicyRuleChildResourceCreateUpdate(ruleType),
+		Delete: firewallPolicyRuleChildResourceDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: firewallPolicyRuleChildResourceSchema(ruleType.schema(), ruleType.actions),
+	}
+}
+
+func firewallPolicyRuleChildResourceCreateUpdate(ruleType firewallPolicyRuleChildResourceType) func(d *pluginsdk.ResourceData, meta interface{}) error {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+		ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		groupId, err := parse.FirewallPolicyRuleCollectionGroupID(d.Get("rule_collection_group_id").(string))
+		if err != nil {
+			return err
+		}
+		id := parse.NewFirewallPolicyRuleID(groupId.SubscriptionId, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName, d.Get("rule_collection_name").(string), d.Get("name").(string))
+
+		locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+		defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+		group, err := client.Get(ctx, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *groupId, err)
+		}
+		if group.FirewallPolicyRuleCollectionGroupProperties == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", *groupId)
+		}
+
+		rules, err := ruleType.expand([]interface{}{firewallPolicyRuleChildResourceValues(d, ruleType.schema())})
+		if err != nil {
+			return fmt.Errorf("expanding %s: %+v", id, err)
+		}
+		if rules == nil || len(*rules) != 1 {
+			return fmt.Errorf("expanding %s: expected a single rule", id)
+		}
+
+		action := ruleType.defaultAction
+		if len(ruleType.actions) > 0 {
+			action = d.Get("rule_collection_action").(string)
+		}
+
+		exists, err := upsertFirewallPolicyRule(group.FirewallPolicyRuleCollectionGroupProperties, id, d.Get("rule_collection_priority").(int), action, (*rules)[0])
+		if err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+		if exists && d.IsNewResource() {
+			return tf.ImportAsExistsError(ruleType.resourceType, id.ID())
+		}
+
+		if err := firewallPolicyRuleCollectionGroupUpdate(ctx, client, *groupId, group); err != nil {
+			return err
+		}
+
+		d.SetId(id.ID())
+
+		return firewallPolicyRuleChildResourceRead(ruleType)(d, meta)
+	}
+}
+
+func firewallPolicyRuleChildResourceRead(ruleType firewallPolicyRuleChildResourceType) func(d *pluginsdk.ResourceData, meta interface{}) error {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+		defer cancel()
+
+		id, err := parse.FirewallPolicyRuleID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		groupId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+		group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+		if err != nil {
+			if utils.ResponseWasNotFound(group.Response) {
+				log.Printf("[DEBUG] %s was not found - removing from state", groupId)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("retrieving %s: %+v", groupId, err)
+		}
+
+		rule, priority, action, exists := findFirewallPolicyRule(group.FirewallPolicyRuleCollectionGroupProperties, *id)
+		if !exists {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		if firewallPolicyRuleType(rule) != ruleType.ruleType {
+			return fmt.Errorf("%s is not %s", *id, ruleType.description)
+		}
+
+		flattened, err := ruleType.flatten(&[]network.BasicFirewallPolicyRule{rule})
+		if err != nil {
+			return fmt.Errorf("flattening %s: %+v", *id, err)
+		}
+
+		d.Set("name", id.RuleName)
+		d.Set("rule_collection_group_id", groupId.ID())
+		d.Set("rule_collection_name", id.RuleCollectionName)
+		d.Set("rule_collection_priority", priority)
+		if len(ruleType.actions) > 0 {
+			d.Set("rule_collection_action", action)
+		}
+
+		return firewallPolicyRuleChildResourceSetValues(d, ruleType.schema(), flattened[0].(map[string]interface{}))
+	}
+}
+
+func firewallPolicyRuleChildResourceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	groupId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+
+	if !removeFirewallPolicyRule(group.FirewallPolicyRuleCollectionGroupProperties, *id) {
+		return nil
+	}
+
+	return firewallPolicyRuleCollectionGroupUpdate(ctx, client, groupId, group)
+}
+
+// firewallPolicyRuleChildResourceSchema builds the schema for a resource managing a single rule within one of the
+// Rule Collections of a Firewall Policy Rule Collection Group, from the schema used for that rule within the
+// `azurerm_firewall_policy_rule_collection_group` resource. The Rule Collection Action is only configurable when
+// `actions` is specified, since NAT Rule Collections only support the `Dnat` action.
+func firewallPolicyRuleChildResourceSchema(ruleSchema map[string]*pluginsdk.Schema, actions []string) map[string]*pluginsdk.Schema {
+	output := make(map[string]*pluginsdk.Schema, len(ruleSchema)+4)
+	for k, v := range ruleSchema {
+		output[k] = v
+	}
+
+	output["name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	output["rule_collection_group_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.FirewallPolicyRuleCollectionGroupID,
+	}
+
+	output["rule_collection_name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	output["rule_collection_priority"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntBetween(100, 65000),
+	}
+
+	if len(actions) > 0 {
+		output["rule_collection_action"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(actions, false),
+		}
+	}
+
+	return output
+}
+
+// firewallPolicyRuleChildResourceValues returns the values for a child resource in the same shape as a single rule
+// within the `azurerm_firewall_policy_rule_collection_group` resource, so that the same expand functions can be used
+func firewallPolicyRuleChildResourceValues(d *pluginsdk.ResourceData, ruleSchema map[string]*pluginsdk.Schema) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k := range ruleSchema {
+		output[k] = d.Get(k)
+	}
+	return output
+}
+
+// firewallPolicyRuleChildResourceSetValues sets the flattened values for a single rule into the state of the child
+// resource managing it
+func firewallPolicyRuleChildResourceSetValues(d *pluginsdk.ResourceData, ruleSchema map[string]*pluginsdk.Schema, values map[string]interface{}) error {
+	for k := range ruleSchema {
+		if k == "name" {
+			continue
+		}
+
+		if err := d.Set(k, values[k]); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+func firewallPolicyRuleCollectionGroupUpdate(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionGroupId, group network.FirewallPolicyRuleCollectionGroup) error {
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName, group)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func firewallPolicyRuleCollectionName(input network.BasicFirewallPolicyRuleCollection) string {
+	if v, ok := input.AsFirewallPolicyFilterRuleCollection(); ok && v.Name != nil {
+		return *v.Name
+	}
+	if v, ok := input.AsFirewallPolicyNatRuleCollection(); ok && v.Name != nil {
+		return *v.Name
+	}
+	return ""
+}
+
+func firewallPolicyRuleName(input network.BasicFirewallPolicyRule) string {
+	if v, ok := input.AsApplicationRule(); ok && v.Name != nil {
+		return *v.Name
+	}
+	if v, ok := input.AsNatRule(); ok && v.Name != nil {
+		return *v.Name
+	}
+	if v, ok := input.AsRule(); ok && v.Name != nil {
+		return *v.Name
+	}
+	return ""
+}
+
+func firewallPolicyRuleType(input network.BasicFirewallPolicyRule) network.RuleType {
+	if _, ok := input.AsApplicationRule(); ok {
+		return network.RuleTypeApplicationRule
+	}
+	if _, ok := input.AsNatRule(); ok {
+		return network.RuleTypeNatRule
+	}
+	if _, ok := input.AsRule(); ok {
+		return network.RuleTypeNetworkRule
+	}
+	return ""
+}
+
+// upsertFirewallPolicyRuleWithinCollection adds the rule to the rules within a Rule Collection, replacing any existing
+// rule with the same name - and returns whether an existing rule was replaced
+func upsertFirewallPolicyRuleWithinCollection(input *[]network.BasicFirewallPolicyRule, collectionName string, rule network.BasicFirewallPolicyRule) (*[]network.BasicFirewallPolicyRule, bool, error) {
+	name := firewallPolicyRuleName(rule)
+	ruleType := firewallPolicyRuleType(rule)
+
+	exists := false
+	rules := make([]network.BasicFirewallPolicyRule, 0)
+	if input != nil {
+		for _, v := range *input {
+			if existingType := firewallPolicyRuleType(v); existingType != ruleType {
+				return nil, false, fmt.Errorf("the Rule Collection %q contains rules of type %q, which cannot be combined with rules of type %q", collectionName, existingType, ruleType)
+			}
+
+			if strings.EqualFold(firewallPolicyRuleName(v), name) {
+				exists = true
+				rules = append(rules, rule)
+				continue
+			}
+
+			rules = append(rules, v)
+		}
+	}
+
+	if !exists {
+		rules = append(rules, rule)
+	}
+
+	return &rules, exists, nil
+}
+
+// checkFirewallPolicyRuleCollectionSettings ensures that the Priority and Action specified for a rule match those of the
+// existing Rule Collection, since these are shared by all of the rules within it. These can only be changed when the
+// Rule Collection contains no other rules.
+func checkFirewallPolicyRuleCollectionSettings(id parse.FirewallPolicyRuleId, rules *[]network.BasicFirewallPolicyRule, existingPriority *int32, existingAction string, priority int, action string) error {
+	if rules == nil {
+		return nil
+	}
+
+	otherRules := false
+	for _, v := range *rules {
+		if !strings.EqualFold(firewallPolicyRuleName(v), id.RuleName) {
+			otherRules = true
+			break
+		}
+	}
+	if !otherRules {
+		return nil
+	}
+
+	if existingPriority != nil && int(*existingPriority) != priority {
+		return fmt.Errorf("the Rule Collection %q has a priority of %d which doesn't match the `rule_collection_priority` of %d - the priority is shared by all rules within the Rule Collection and so must be the same for each of them", id.RuleCollectionName, *existingPriority, priority)
+	}
+
+	if existingAction != "" && !strings.EqualFold(existingAction, action) {
+		return fmt.Errorf("the Rule Collection %q has an action of %q which doesn't match the `rule_collection_action` of %q - the action is shared by all rules within the Rule Collection and so must be the same for each of them", id.RuleCollectionName, existingAction, action)
+	}
+
+	return nil
+}
+
+// upsertFirewallPolicyRule adds the rule to the specified Rule Collection within the Rule Collection Group, replacing any
+// existing rule with the same name and creating the Rule Collection if it doesn't exist. The Priority and Action of an
+// existing Rule Collection must match those specified, unless it contains no other rules - in which case they're updated.
+// Returns whether an existing rule was replaced.
+func upsertFirewallPolicyRule(props *network.FirewallPolicyRuleCollectionGroupProperties, id parse.FirewallPolicyRuleId, priority int, action string, rule network.BasicFirewallPolicyRule) (bool, error) {
+	isNatRule := firewallPolicyRuleType(rule) == network.RuleTypeNatRule
+
+	collections := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	if props.RuleCollections != nil {
+		collections = *props.RuleCollections
+	}
+
+	for i, v := range collections {
+		if !strings.EqualFold(firewallPolicyRuleCollectionName(v), id.RuleCollectionName) {
+			continue
+		}
+
+		if isNatRule {
+			collection, ok := v.AsFirewallPolicyNatRuleCollection()
+			if !ok {
+				return false, fmt.Errorf("the Rule Collection %q is not a NAT Rule Collection", id.RuleCollectionName)
+			}
+
+			existingAction := ""
+			if collection.Action != nil {
+				existingAction = string(collection.Action.Type)
+			}
+			if err := checkFirewallPolicyRuleCollectionSettings(id, collection.Rules, collection.Priority, existingAction, priority, action); err != nil {
+				return false, err
+			}
+
+			rules, exists, err := upsertFirewallPolicyRuleWithinCollection(collection.Rules, id.RuleCollectionName, rule)
+			if err != nil {
+				return false, err
+			}
+
+			collection.Rules = rules
+			collection.Priority = utils.Int32(int32(priority))
+			collection.Action = &network.FirewallPolicyNatRuleCollectionAction{
+				Type: network.FirewallPolicyNatRuleCollectionActionType(action),
+			}
+			collections[i] = *collection
+			props.RuleCollections = &collections
+			return exists, nil
+		}
+
+		collection, ok := v.AsFirewallPolicyFilterRuleCollection()
+		if !ok {
+			return false, fmt.Errorf("the Rule Collection %q is not a Filter Rule Collection", id.RuleCollectionName)
+		}
+
+		existingAction := ""
+		if collection.Action != nil {
+			existingAction = string(collection.Action.Type)
+		}
+		if err := checkFirewallPolicyRuleCollectionSettings(id, collection.Rules, collection.Priority, existingAction, priority, action); err != nil {
+			return false, err
+		}
+
+		rules, exists, err := upsertFirewallPolicyRuleWithinCollection(collection.Rules, id.RuleCollectionName, rule)
+		if err != nil {
+			return false, err
+		}
+
+		collection.Rules = rules
+		collection.Priority = utils.Int32(int32(priority))
+		collection.Action = &network.FirewallPolicyFilterRuleCollectionAction{
+			Type: network.FirewallPolicyFilterRuleCollectionActionType(action),
+		}
+		collections[i] = *collection
+		props.RuleCollections = &collections
+		return exists, nil
+	}
+
+	rules := []network.BasicFirewallPolicyRule{rule}
+	if isNatRule {
+		collections = append(collections, network.FirewallPolicyNatRuleCollection{
+			RuleCollectionType: network.RuleCollectionTypeFirewallPolicyNatRuleCollection,
+			Name:               utils.String(id.RuleCollectionName),
+			Priority:           utils.Int32(int32(priority)),
+			Action: &network.FirewallPolicyNatRuleCollectionAction{
+				Type: network.FirewallPolicyNatRuleCollectionActionType(action),
+			},
+			Rules: &rules,
+		})
+	} else {
+		collections = append(collections, network.FirewallPolicyFilterRuleCollection{
+			RuleCollectionType: network.RuleCollectionTypeFirewallPolicyFilterRuleCollection,
+			Name:               utils.String(id.RuleCollectionName),
+			Priority:           utils.Int32(int32(priority)),
+			Action: &network.FirewallPolicyFilterRuleCollectionAction{
+				Type: network.FirewallPolicyFilterRuleCollectionActionType(action),
+			},
+			Rules: &rules,
+		})
+	}
+	props.RuleCollections = &collections
+
+	return false, nil
+}
+
+// findFirewallPolicyRule returns the rule along with the Priority and Action of the Rule Collection containing it
+func findFirewallPolicyRule(props *network.FirewallPolicyRuleCollectionGroupProperties, id parse.FirewallPolicyRuleId) (network.BasicFirewallPolicyRule, int, string, bool) {
+	if props == nil || props.RuleCollections == nil {
+		return nil, 0, "", false
+	}
+
+	for _, v := range *props.RuleCollections {
+		if !strings.EqualFold(firewallPolicyRuleCollectionName(v), id.RuleCollectionName) {
+			continue
+		}
+
+		var rules *[]network.BasicFirewallPolicyRule
+		priority := 0
+		action := ""
+		if collection, ok := v.AsFirewallPolicyNatRuleCollection(); ok {
+			rules = collection.Rules
+			if collection.Priority != nil {
+				priority = int(*collection.Priority)
+			}
+			if collection.Action != nil {
+				action = string(collection.Action.Type)
+			}
+		} else if collection, ok := v.AsFirewallPolicyFilterRuleCollection(); ok {
+			rules = collection.Rules
+			if collection.Priority != nil {
+				priority = int(*collection.Priority)
+			}
+			if collection.Action != nil {
+				action = string(collection.Action.Type)
+			}
+		}
+
+		if rules == nil {
+			return nil, 0, "", false
+		}
+
+		for _, rule := range *rules {
+			if strings.EqualFold(firewallPolicyRuleName(rule), id.RuleName) {
+				return rule, priority, action, true
+			}
+		}
+	}
+
+	return nil, 0, "", false
+}
+
+// removeFirewallPolicyRule removes the rule from the Rule Collection Group, removing the Rule Collection containing it
+// if it's then empty - and returns whether the rule was found
+func removeFirewallPolicyRule(props *network.FirewallPolicyRuleCollectionGroupProperties, id parse.FirewallPolicyRuleId) bool {
+	if props == nil || props.RuleCollections == nil {
+		return false
+	}
+
+	filterRules := func(input *[]network.BasicFirewallPolicyRule) ([]network.BasicFirewallPolicyRule, bool) {
+		output := make([]network.BasicFirewallPolicyRule, 0)
+		found := false
+		if input != nil {
+			for _, rule := range *input {
+				if strings.EqualFold(firewallPolicyRuleName(rule), id.RuleName) {
+					found = true
+					continue
+				}
+				output = append(output, rule)
+			}
+		}
+		return output, found
+	}
+
+	found := false
+	collections := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	for _, v := range *props.RuleCollections {
+		if !strings.EqualFold(firewallPolicyRuleCollectionName(v), id.RuleCollectionName) {
+			collections = append(collections, v)
+			continue
+		}
+
+		if collection, ok := v.AsFirewallPolicyNatRuleCollection(); ok {
+			rules, removed := filterRules(collection.Rules)
+			found = found || removed
+			if len(rules) > 0 {
+				collection.Rules = &rules
+				collections = append(collections, *collection)
+			}
+			continue
+		}
+
+		if collection, ok := v.AsFirewallPolicyFilterRuleCollection(); ok {
+			rules, removed := filterRules(collection.Rules)
+			found = found || removed
+			if len(rules) > 0 {
+				collection.Rules = &rules
+				collections = append(collections, *collection)
+			}
+			continue
+		}
+
+		collections = append(collections, v)
+	}
+
+	props.RuleCollections = &collections
+	return found
+}
+
+func firewallPolicyRuleCollectionNames(items []interface{}) map[string]struct{} {
+	output := make(map[string]struct{})
+	for _, raw := range items {
+		if v, ok := raw.(map[string]interface{}); ok {
+			if name, ok := v["name"].(string); ok && name != "" {
+				output[strings.ToLower(name)] = struct{}{}
+			}
+		}
+	}
+	return output
+}
+
+// firewallPolicyKnownRuleCollections returns the names of the Rule Collections which are managed by the
+// `azurerm_firewall_policy_rule_collection_group` resource - that is, those in either the configuration or the state
+func firewallPolicyKnownRuleCollections(d *pluginsdk.ResourceData) map[string]struct{} {
+	known := make(map[string]struct{})
+	for _, key := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		oldItems, newItems := d.GetChange(key)
+		for _, items := range []interface{}{oldItems, newItems} {
+			if v, ok := items.([]interface{}); ok {
+				for name := range firewallPolicyRuleCollectionNames(v) {
+					known[name] = struct{}{}
+				}
+			}
+		}
+	}
+	return known
+}
+
+// preserveFirewallPolicyExternalRuleCollections copies across any Rule Collections which contain rules managed by their
+// own resources (such as `azurerm_firewall_policy_network_rule`) from the existing Rule Collection Group into the payload,
+// so that they're not removed when the `azurerm_firewall_policy_rule_collection_group` resource is updated
+func preserveFirewallPolicyExternalRuleCollections(d *pluginsdk.ResourceData, existing *[]network.BasicFirewallPolicyRuleCollection, collections []network.BasicFirewallPolicyRuleCollection) []network.BasicFirewallPolicyRuleCollection {
+	if existing == nil {
+		return collections
+	}
+
+	known := firewallPolicyKnownRuleCollections(d)
+	for _, v := range *existing {
+		if _, exists := known[strings.ToLower(firewallPolicyRuleCollectionName(v))]; !exists {
+			collections = append(collections, v)
+		}
+	}
+
+	return collections
+}
+
+// filterFirewallPolicyExternalRuleCollections removes any Rule Collections which aren't managed by the
+// `azurerm_firewall_policy_rule_collection_group` resource from the flattened block
+func filterFirewallPolicyExternalRuleCollections(d *pluginsdk.ResourceData, key string, input []interface{}) []interface{} {
+	if !d.Get("ignore_external_child_resources").(bool) {
+		return input
+	}
+
+	known := firewallPolicyRuleCollectionNames(d.Get(key).([]interface{}))
+
+	output := make([]interface{}, 0)
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := v["name"].(string)
+		if _, exists := known[strings.ToLower(name)]; exists {
+			output = append(output, raw)
+		}
+	}
+	return output
+}
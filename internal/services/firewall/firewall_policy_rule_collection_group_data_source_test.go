@@ -0,0 +1,39 @@
+package firewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type FirewallPolicyRuleCollectionGroupDataSource struct{}
+
+func TestAccFirewallPolicyRuleCollectionGroupDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("priority").HasValue("500"),
+				check.That(data.ResourceName).Key("application_rule_collection.#").HasValue("1"),
+				check.That(data.ResourceName).Key("network_rule_collection.#").HasValue("1"),
+				check.That(data.ResourceName).Key("nat_rule_collection.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (FirewallPolicyRuleCollectionGroupDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = azurerm_firewall_policy_rule_collection_group.test.name
+  firewall_policy_id = azurerm_firewall_policy_rule_collection_group.test.firewall_policy_id
+}
+`, FirewallPolicyRuleCollectionGroupResource{}.complete(data))
+}
@@ -28,8 +28,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_firewall":        firewallDataSource(),
-		"azurerm_firewall_policy": FirewallDataSourcePolicy(),
+		"azurerm_firewall":                              firewallDataSource(),
+		"azurerm_firewall_policy":                       FirewallDataSourcePolicy(),
+		"azurerm_firewall_policy_rule_collection_group": FirewallDataSourcePolicyRuleCollectionGroup(),
 	}
 }
 
@@ -38,6 +39,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_firewall_application_rule_collection":  resourceFirewallApplicationRuleCollection(),
 		"azurerm_firewall_policy":                       resourceFirewallPolicy(),
+		"azurerm_firewall_policy_application_rule":      resourceFirewallPolicyApplicationRule(),
+		"azurerm_firewall_policy_draft":                 resourceFirewallPolicyDraft(),
+		"azurerm_firewall_policy_nat_rule":              resourceFirewallPolicyNatRule(),
+		"azurerm_firewall_policy_network_rule":          resourceFirewallPolicyNetworkRule(),
 		"azurerm_firewall_policy_rule_collection_group": resourceFirewallPolicyRuleCollectionGroup(),
 		"azurerm_firewall_nat_rule_collection":          resourceFirewallNatRuleCollection(),
 		"azurerm_firewall_network_rule_collection":      resourceFirewallNetworkRuleCollection(),
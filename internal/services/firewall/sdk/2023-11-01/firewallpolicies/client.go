@@ -0,0 +1,15 @@
+package firewallpolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FirewallPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFirewallPoliciesClientWithBaseURI(endpoint string) FirewallPoliciesClient {
+	return FirewallPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
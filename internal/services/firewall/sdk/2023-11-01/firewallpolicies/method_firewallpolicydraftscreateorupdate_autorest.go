@@ -0,0 +1,66 @@
+package firewallpolicies
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type FirewallPolicyDraftsCreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *FirewallPolicyDraft
+}
+
+// FirewallPolicyDraftsCreateOrUpdate ...
+func (c FirewallPoliciesClient) FirewallPolicyDraftsCreateOrUpdate(ctx context.Context, id FirewallPolicyId, input FirewallPolicyDraft) (result FirewallPolicyDraftsCreateOrUpdateOperationResponse, err error) {
+	req, err := c.preparerForFirewallPolicyDraftsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "FirewallPolicyDraftsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "FirewallPolicyDraftsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForFirewallPolicyDraftsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "FirewallPolicyDraftsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForFirewallPolicyDraftsCreateOrUpdate prepares the FirewallPolicyDraftsCreateOrUpdate request.
+func (c FirewallPoliciesClient) preparerForFirewallPolicyDraftsCreateOrUpdate(ctx context.Context, id FirewallPolicyId, input FirewallPolicyDraft) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/firewallPolicyDrafts/default", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForFirewallPolicyDraftsCreateOrUpdate handles the response to the FirewallPolicyDraftsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c FirewallPoliciesClient) responderForFirewallPolicyDraftsCreateOrUpdate(resp *http.Response) (result FirewallPolicyDraftsCreateOrUpdateOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
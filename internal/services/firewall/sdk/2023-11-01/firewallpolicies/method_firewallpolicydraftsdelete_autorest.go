@@ -0,0 +1,62 @@
+package firewallpolicies
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type FirewallPolicyDraftsDeleteOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// FirewallPolicyDraftsDelete ...
+func (c FirewallPoliciesClient) FirewallPolicyDraftsDelete(ctx context.Context, id FirewallPolicyId) (result FirewallPolicyDraftsDeleteOperationResponse, err error) {
+	req, err := c.preparerForFirewallPolicyDraftsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "FirewallPolicyDraftsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "FirewallPolicyDraftsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForFirewallPolicyDraftsDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "FirewallPolicyDraftsDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForFirewallPolicyDraftsDelete prepares the FirewallPolicyDraftsDelete request.
+func (c FirewallPoliciesClient) preparerForFirewallPolicyDraftsDelete(ctx context.Context, id FirewallPolicyId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/firewallPolicyDrafts/default", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForFirewallPolicyDraftsDelete handles the response to the FirewallPolicyDraftsDelete request. The method always
+// closes the http.Response Body.
+func (c FirewallPoliciesClient) responderForFirewallPolicyDraftsDelete(resp *http.Response) (result FirewallPolicyDraftsDeleteOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package firewallpolicies
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type FirewallPolicyDraftsGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *FirewallPolicyDraft
+}
+
+// FirewallPolicyDraftsGet ...
+func (c FirewallPoliciesClient) FirewallPolicyDraftsGet(ctx context.Context, id FirewallPolicyId) (result FirewallPolicyDraftsGetOperationResponse, err error) {
+	req, err := c.preparerForFirewallPolicyDraftsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "FirewallPolicyDraftsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "FirewallPolicyDraftsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForFirewallPolicyDraftsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicies.FirewallPoliciesClient", "FirewallPolicyDraftsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForFirewallPolicyDraftsGet prepares the FirewallPolicyDraftsGet request.
+func (c FirewallPoliciesClient) preparerForFirewallPolicyDraftsGet(ctx context.Context, id FirewallPolicyId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/firewallPolicyDrafts/default", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForFirewallPolicyDraftsGet handles the response to the FirewallPolicyDraftsGet request. The method always
+// closes the http.Response Body.
+func (c FirewallPoliciesClient) responderForFirewallPolicyDraftsGet(resp *http.Response) (result FirewallPolicyDraftsGetOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
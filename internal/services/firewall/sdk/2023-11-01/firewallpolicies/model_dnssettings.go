@@ -0,0 +1,6 @@
+package firewallpolicies
+
+type DnsSettings struct {
+	EnableProxy *bool     `json:"enableProxy,omitempty"`
+	Servers     *[]string `json:"servers,omitempty"`
+}
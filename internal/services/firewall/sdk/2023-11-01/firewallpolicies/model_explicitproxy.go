@@ -0,0 +1,10 @@
+package firewallpolicies
+
+type ExplicitProxy struct {
+	EnableExplicitProxy *bool   `json:"enableExplicitProxy,omitempty"`
+	EnablePacFile       *bool   `json:"enablePacFile,omitempty"`
+	HTTPPort            *int64  `json:"httpPort,omitempty"`
+	HTTPSPort           *int64  `json:"httpsPort,omitempty"`
+	PacFile             *string `json:"pacFile,omitempty"`
+	PacFilePort         *int64  `json:"pacFilePort,omitempty"`
+}
@@ -0,0 +1,6 @@
+package firewallpolicies
+
+type FirewallPolicyCertificateAuthority struct {
+	KeyVaultSecretId *string `json:"keyVaultSecretId,omitempty"`
+	Name             *string `json:"name,omitempty"`
+}
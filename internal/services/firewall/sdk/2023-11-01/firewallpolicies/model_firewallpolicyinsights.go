@@ -0,0 +1,7 @@
+package firewallpolicies
+
+type FirewallPolicyInsights struct {
+	IsEnabled             *bool                                `json:"isEnabled,omitempty"`
+	LogAnalyticsResources *FirewallPolicyLogAnalyticsResources `json:"logAnalyticsResources,omitempty"`
+	RetentionDays         *int64                               `json:"retentionDays,omitempty"`
+}
@@ -0,0 +1,6 @@
+package firewallpolicies
+
+type FirewallPolicyIntrusionDetection struct {
+	Configuration *FirewallPolicyIntrusionDetectionConfiguration `json:"configuration,omitempty"`
+	Mode          *FirewallPolicyIntrusionDetectionStateType     `json:"mode,omitempty"`
+}
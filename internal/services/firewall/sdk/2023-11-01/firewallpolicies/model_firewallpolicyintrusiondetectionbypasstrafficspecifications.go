@@ -0,0 +1,12 @@
+package firewallpolicies
+
+type FirewallPolicyIntrusionDetectionBypassTrafficSpecifications struct {
+	Description          *string                                   `json:"description,omitempty"`
+	DestinationAddresses *[]string                                 `json:"destinationAddresses,omitempty"`
+	DestinationIPGroups  *[]string                                 `json:"destinationIpGroups,omitempty"`
+	DestinationPorts     *[]string                                 `json:"destinationPorts,omitempty"`
+	Name                 *string                                   `json:"name,omitempty"`
+	Protocol             *FirewallPolicyIntrusionDetectionProtocol `json:"protocol,omitempty"`
+	SourceAddresses      *[]string                                 `json:"sourceAddresses,omitempty"`
+	SourceIPGroups       *[]string                                 `json:"sourceIpGroups,omitempty"`
+}
@@ -0,0 +1,7 @@
+package firewallpolicies
+
+type FirewallPolicyIntrusionDetectionConfiguration struct {
+	BypassTrafficSettings *[]FirewallPolicyIntrusionDetectionBypassTrafficSpecifications `json:"bypassTrafficSettings,omitempty"`
+	PrivateRanges         *[]string                                                      `json:"privateRanges,omitempty"`
+	SignatureOverrides    *[]FirewallPolicyIntrusionDetectionSignatureSpecification      `json:"signatureOverrides,omitempty"`
+}
@@ -0,0 +1,6 @@
+package firewallpolicies
+
+type FirewallPolicyIntrusionDetectionSignatureSpecification struct {
+	Id   *string                                    `json:"id,omitempty"`
+	Mode *FirewallPolicyIntrusionDetectionStateType `json:"mode,omitempty"`
+}
@@ -0,0 +1,6 @@
+package firewallpolicies
+
+type FirewallPolicyLogAnalyticsResources struct {
+	DefaultWorkspaceId *SubResource                           `json:"defaultWorkspaceId,omitempty"`
+	Workspaces         *[]FirewallPolicyLogAnalyticsWorkspace `json:"workspaces,omitempty"`
+}
@@ -0,0 +1,6 @@
+package firewallpolicies
+
+type FirewallPolicyLogAnalyticsWorkspace struct {
+	Region      *string      `json:"region,omitempty"`
+	WorkspaceId *SubResource `json:"workspaceId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package firewallpolicies
+
+type FirewallPolicySku struct {
+	Tier *FirewallPolicySkuTier `json:"tier,omitempty"`
+}
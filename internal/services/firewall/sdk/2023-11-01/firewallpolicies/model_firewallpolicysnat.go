@@ -0,0 +1,6 @@
+package firewallpolicies
+
+type FirewallPolicySNAT struct {
+	AutoLearnPrivateRanges *AutoLearnPrivateRangesMode `json:"autoLearnPrivateRanges,omitempty"`
+	PrivateRanges          *[]string                   `json:"privateRanges,omitempty"`
+}
@@ -0,0 +1,5 @@
+package firewallpolicies
+
+type FirewallPolicySQL struct {
+	AllowSqlRedirect *bool `json:"allowSqlRedirect,omitempty"`
+}
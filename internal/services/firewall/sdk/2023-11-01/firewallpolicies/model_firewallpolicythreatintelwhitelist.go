@@ -0,0 +1,6 @@
+package firewallpolicies
+
+type FirewallPolicyThreatIntelWhitelist struct {
+	Fqdns       *[]string `json:"fqdns,omitempty"`
+	IPAddresses *[]string `json:"ipAddresses,omitempty"`
+}
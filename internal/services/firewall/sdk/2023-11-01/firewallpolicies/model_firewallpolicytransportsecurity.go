@@ -0,0 +1,5 @@
+package firewallpolicies
+
+type FirewallPolicyTransportSecurity struct {
+	CertificateAuthority *FirewallPolicyCertificateAuthority `json:"certificateAuthority,omitempty"`
+}
@@ -0,0 +1,5 @@
+package firewallpolicies
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,160 @@
+package frontdoor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2024-02-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceFrontDoorFirewallPolicyRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.FrontDoorWAFName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"location": commonschema.LocationComputed(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"redirect_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"custom_block_response_status_code": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"custom_block_response_body": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"request_body_check_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"js_challenge_cookie_expiration_in_minutes": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"frontend_endpoint_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"tags": commonschema.TagsDataSource(),
+		},
+	}
+}
+
+func dataSourceFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := webapplicationfirewallpolicies.NewFrontDoorWebApplicationFirewallPoliciesID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	resp, err := client.PoliciesGet(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.PolicyName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
+		}
+
+		skuName := string(webapplicationfirewallpolicies.SkuNameClassicAzureFrontDoor)
+		if sku := model.Sku; sku != nil && sku.Name != nil {
+			skuName = string(*sku.Name)
+		}
+		d.Set("sku_name", skuName)
+
+		if properties := model.Properties; properties != nil {
+			if policy := properties.PolicySettings; policy != nil {
+				enabled := false
+				if policy.EnabledState != nil {
+					enabled = *policy.EnabledState == webapplicationfirewallpolicies.PolicyEnabledStateEnabled
+				}
+				d.Set("enabled", enabled)
+
+				mode := ""
+				if policy.Mode != nil {
+					mode = string(*policy.Mode)
+				}
+				d.Set("mode", mode)
+
+				d.Set("redirect_url", policy.RedirectUrl)
+				d.Set("custom_block_response_status_code", policy.CustomBlockResponseStatusCode)
+				d.Set("custom_block_response_body", policy.CustomBlockResponseBody)
+
+				requestBodyCheckEnabled := true
+				if policy.RequestBodyCheck != nil {
+					requestBodyCheckEnabled = *policy.RequestBodyCheck == webapplicationfirewallpolicies.PolicyRequestBodyCheckEnabled
+				}
+				d.Set("request_body_check_enabled", requestBodyCheckEnabled)
+
+				jsChallengeExpiration := 0
+				if policy.JavascriptChallengeExpirationInMinutes != nil {
+					jsChallengeExpiration = int(*policy.JavascriptChallengeExpirationInMinutes)
+				}
+				d.Set("js_challenge_cookie_expiration_in_minutes", jsChallengeExpiration)
+			}
+
+			if err := d.Set("frontend_endpoint_ids", FlattenFrontendEndpointLinkSlice(properties.FrontendEndpointLinks)); err != nil {
+				return fmt.Errorf("flattening `frontend_endpoint_ids`: %+v", err)
+			}
+		}
+
+		return tags.FlattenAndSet(d, model.Tags)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package frontdoor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type FrontDoorFirewallPolicyDataSource struct{}
+
+func TestAccFrontDoorFirewallPolicyDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_frontdoor_firewall_policy", "test")
+	d := FrontDoorFirewallPolicyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("testAccFrontDoorWAF%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("sku_name").HasValue("Premium_AzureFrontDoor"),
+				check.That(data.ResourceName).Key("mode").HasValue("Prevention"),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("js_challenge_cookie_expiration_in_minutes").HasValue("45"),
+			),
+		},
+	})
+}
+
+func (FrontDoorFirewallPolicyDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_frontdoor_firewall_policy" "test" {
+  name                = azurerm_frontdoor_firewall_policy.test.name
+  resource_group_name = azurerm_frontdoor_firewall_policy.test.resource_group_name
+}
+`, FrontDoorFirewallPolicyResource{}.premium(data))
+}
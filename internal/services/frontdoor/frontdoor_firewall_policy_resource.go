@@ -13,7 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-04-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2024-02-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -57,6 +57,18 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(webapplicationfirewallpolicies.SkuNameClassicAzureFrontDoor),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(webapplicationfirewallpolicies.SkuNameClassicAzureFrontDoor),
+					string(webapplicationfirewallpolicies.SkuNamePremiumAzureFrontDoor),
+					string(webapplicationfirewallpolicies.SkuNameStandardAzureFrontDoor),
+				}, false),
+			},
+
 			"enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -97,6 +109,68 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 				ValidateFunc: validate.CustomBlockResponseBody,
 			},
 
+			"request_body_check_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"js_challenge_cookie_expiration_in_minutes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+
+			"log_scrubbing": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"scrubbing_rule": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"match_variable": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForScrubbingRuleEntryMatchVariable(), false),
+									},
+
+									"operator": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Default:      string(webapplicationfirewallpolicies.ScrubbingRuleEntryMatchOperatorEquals),
+										ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForScrubbingRuleEntryMatchOperator(), false),
+									},
+
+									"selector": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"custom_rule": {
 				Type:     pluginsdk.TypeList,
 				MaxItems: 100,
@@ -131,15 +205,27 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 						},
 
 						"rate_limit_duration_in_minutes": {
-							Type:     pluginsdk.TypeInt,
-							Optional: true,
-							Default:  1,
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntInSlice([]int{1, 5}),
 						},
 
 						"rate_limit_threshold": {
-							Type:     pluginsdk.TypeInt,
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"group_by": {
+							Type:     pluginsdk.TypeList,
 							Optional: true,
-							Default:  10,
+							MaxItems: 2,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForVariableName(), false),
+							},
 						},
 
 						"action": {
@@ -148,6 +234,7 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								string(webapplicationfirewallpolicies.ActionTypeAllow),
 								string(webapplicationfirewallpolicies.ActionTypeBlock),
+								string(webapplicationfirewallpolicies.ActionTypeJSChallenge),
 								string(webapplicationfirewallpolicies.ActionTypeLog),
 								string(webapplicationfirewallpolicies.ActionTypeRedirect),
 							}, false),
@@ -257,41 +344,7 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
-						"exclusion": {
-							Type:     pluginsdk.TypeList,
-							MaxItems: 100,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"match_variable": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
-											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
-											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
-											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
-										}, false),
-									},
-									"operator": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorContains),
-											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEndsWith),
-											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEquals),
-											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEqualsAny),
-											string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorStartsWith),
-										}, false),
-									},
-									"selector": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-								},
-							},
-						},
+						"exclusion": frontDoorFirewallManagedRuleExclusionSchema(),
 
 						"override": {
 							Type:     pluginsdk.TypeList,
@@ -305,41 +358,7 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
 
-									"exclusion": {
-										Type:     pluginsdk.TypeList,
-										MaxItems: 100,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"match_variable": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
-														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
-														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
-														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
-													}, false),
-												},
-												"operator": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorContains),
-														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEndsWith),
-														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEquals),
-														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEqualsAny),
-														string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorStartsWith),
-													}, false),
-												},
-												"selector": {
-													Type:         pluginsdk.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringIsNotEmpty,
-												},
-											},
-										},
-									},
+									"exclusion": frontDoorFirewallManagedRuleExclusionSchema(),
 
 									"rule": {
 										Type:     pluginsdk.TypeList,
@@ -359,48 +378,18 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 													Default:  false,
 												},
 
-												"exclusion": {
-													Type:     pluginsdk.TypeList,
-													MaxItems: 100,
-													Optional: true,
-													Elem: &pluginsdk.Resource{
-														Schema: map[string]*pluginsdk.Schema{
-															"match_variable": {
-																Type:     pluginsdk.TypeString,
-																Required: true,
-																ValidateFunc: validation.StringInSlice([]string{
-																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
-																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
-																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
-																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
-																}, false),
-															},
-															"operator": {
-																Type:     pluginsdk.TypeString,
-																Required: true,
-																ValidateFunc: validation.StringInSlice([]string{
-																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorContains),
-																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEndsWith),
-																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEquals),
-																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEqualsAny),
-																	string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorStartsWith),
-																}, false),
-															},
-															"selector": {
-																Type:         pluginsdk.TypeString,
-																Required:     true,
-																ValidateFunc: validation.StringIsNotEmpty,
-															},
-														},
-													},
-												},
+												"exclusion": frontDoorFirewallManagedRuleExclusionSchema(),
 
+												// DRS 2.x rule sets only support `AnomalyScoring` and `Log`, whilst the
+												// Bot Manager rule sets additionally support `JSChallenge`
 												"action": {
 													Type:     pluginsdk.TypeString,
 													Required: true,
 													ValidateFunc: validation.StringInSlice([]string{
 														string(webapplicationfirewallpolicies.ActionTypeAllow),
+														string(webapplicationfirewallpolicies.ActionTypeAnomalyScoring),
 														string(webapplicationfirewallpolicies.ActionTypeBlock),
+														string(webapplicationfirewallpolicies.ActionTypeJSChallenge),
 														string(webapplicationfirewallpolicies.ActionTypeLog),
 														string(webapplicationfirewallpolicies.ActionTypeRedirect),
 													}, false),
@@ -428,6 +417,45 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 	}
 }
 
+func frontDoorFirewallManagedRuleExclusionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		MaxItems: 100,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"match_variable": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames),
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
+					}, false),
+				},
+				"operator": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorContains),
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEndsWith),
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEquals),
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorEqualsAny),
+						string(webapplicationfirewallpolicies.ManagedRuleExclusionSelectorMatchOperatorStartsWith),
+					}, false),
+				},
+				"selector": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
 func resourceFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -463,6 +491,11 @@ func resourceFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta
 	customBlockResponseBody := d.Get("custom_block_response_body").(string)
 	customRules := d.Get("custom_rule").([]interface{})
 	managedRules := d.Get("managed_rule").([]interface{})
+	sku := webapplicationfirewallpolicies.SkuName(d.Get("sku_name").(string))
+	requestBodyCheck := webapplicationfirewallpolicies.PolicyRequestBodyCheckDisabled
+	if d.Get("request_body_check_enabled").(bool) {
+		requestBodyCheck = webapplicationfirewallpolicies.PolicyRequestBodyCheckEnabled
+	}
 
 	t := d.Get("tags").(map[string]interface{})
 
@@ -471,12 +504,17 @@ func resourceFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta
 		Location: utils.String(location),
 		Properties: &webapplicationfirewallpolicies.WebApplicationFirewallPolicyProperties{
 			PolicySettings: &webapplicationfirewallpolicies.PolicySettings{
-				EnabledState: &enabled,
-				Mode:         &mode,
+				EnabledState:     &enabled,
+				Mode:             &mode,
+				RequestBodyCheck: &requestBodyCheck,
+				LogScrubbing:     expandFrontDoorFirewallLogScrubbing(d.Get("log_scrubbing").([]interface{})),
 			},
 			CustomRules:  expandFrontDoorFirewallCustomRules(customRules),
 			ManagedRules: expandFrontDoorFirewallManagedRules(managedRules),
 		},
+		Sku: &webapplicationfirewallpolicies.Sku{
+			Name: &sku,
+		},
 		Tags: tags.Expand(t),
 	}
 
@@ -489,6 +527,9 @@ func resourceFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta
 	if customBlockResponseStatusCode > 0 {
 		frontdoorWebApplicationFirewallPolicy.Properties.PolicySettings.CustomBlockResponseStatusCode = utils.Int64(int64(customBlockResponseStatusCode))
 	}
+	if v, ok := d.GetOk("js_challenge_cookie_expiration_in_minutes"); ok {
+		frontdoorWebApplicationFirewallPolicy.Properties.PolicySettings.JavascriptChallengeExpirationInMinutes = utils.Int64(int64(v.(int)))
+	}
 
 	if err := client.PoliciesCreateOrUpdateThenPoll(ctx, id, frontdoorWebApplicationFirewallPolicy); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -525,6 +566,12 @@ func resourceFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta interfa
 		if location := model.Location; location != nil {
 			d.Set("location", azure.NormalizeLocation(*location))
 		}
+		skuName := string(webapplicationfirewallpolicies.SkuNameClassicAzureFrontDoor)
+		if sku := model.Sku; sku != nil && sku.Name != nil {
+			skuName = string(*sku.Name)
+		}
+		d.Set("sku_name", skuName)
+
 		if properties := model.Properties; properties != nil {
 			if policy := properties.PolicySettings; policy != nil {
 				if policy.EnabledState != nil {
@@ -536,6 +583,22 @@ func resourceFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta interfa
 				d.Set("redirect_url", policy.RedirectUrl)
 				d.Set("custom_block_response_status_code", policy.CustomBlockResponseStatusCode)
 				d.Set("custom_block_response_body", policy.CustomBlockResponseBody)
+
+				requestBodyCheckEnabled := true
+				if policy.RequestBodyCheck != nil {
+					requestBodyCheckEnabled = *policy.RequestBodyCheck == webapplicationfirewallpolicies.PolicyRequestBodyCheckEnabled
+				}
+				d.Set("request_body_check_enabled", requestBodyCheckEnabled)
+
+				jsChallengeExpiration := 0
+				if policy.JavascriptChallengeExpirationInMinutes != nil {
+					jsChallengeExpiration = int(*policy.JavascriptChallengeExpirationInMinutes)
+				}
+				d.Set("js_challenge_cookie_expiration_in_minutes", jsChallengeExpiration)
+
+				if err := d.Set("log_scrubbing", flattenFrontDoorFirewallLogScrubbing(policy.LogScrubbing)); err != nil {
+					return fmt.Errorf("flattening `log_scrubbing`: %+v", err)
+				}
 			}
 
 			if err := d.Set("custom_rule", flattenFrontDoorFirewallCustomRules(properties.CustomRules)); err != nil {
@@ -594,6 +657,7 @@ func expandFrontDoorFirewallCustomRules(input []interface{}) *webapplicationfire
 		rateLimitDurationInMinutes := int64(custom["rate_limit_duration_in_minutes"].(int))
 		rateLimitThreshold := int64(custom["rate_limit_threshold"].(int))
 		matchConditions := expandFrontDoorFirewallMatchConditions(custom["match_condition"].([]interface{}))
+		groupBy := expandFrontDoorFirewallGroupByVariables(custom["group_by"].([]interface{}))
 		action := custom["action"].(string)
 
 		customRule := webapplicationfirewallpolicies.CustomRule{
@@ -604,6 +668,7 @@ func expandFrontDoorFirewallCustomRules(input []interface{}) *webapplicationfire
 			RateLimitDurationInMinutes: utils.Int64(rateLimitDurationInMinutes),
 			RateLimitThreshold:         utils.Int64(rateLimitThreshold),
 			MatchConditions:            matchConditions,
+			GroupBy:                    groupBy,
 			Action:                     webapplicationfirewallpolicies.ActionType(action),
 		}
 		output = append(output, customRule)
@@ -614,6 +679,61 @@ func expandFrontDoorFirewallCustomRules(input []interface{}) *webapplicationfire
 	}
 }
 
+func expandFrontDoorFirewallGroupByVariables(input []interface{}) *[]webapplicationfirewallpolicies.GroupByVariable {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]webapplicationfirewallpolicies.GroupByVariable, 0)
+	for _, v := range input {
+		result = append(result, webapplicationfirewallpolicies.GroupByVariable{
+			VariableName: webapplicationfirewallpolicies.VariableName(v.(string)),
+		})
+	}
+
+	return &result
+}
+
+func expandFrontDoorFirewallLogScrubbing(input []interface{}) *webapplicationfirewallpolicies.PolicySettingsLogScrubbing {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	state := webapplicationfirewallpolicies.WebApplicationFirewallScrubbingStateDisabled
+	if v["enabled"].(bool) {
+		state = webapplicationfirewallpolicies.WebApplicationFirewallScrubbingStateEnabled
+	}
+
+	rules := make([]webapplicationfirewallpolicies.WebApplicationFirewallScrubbingRules, 0)
+	for _, r := range v["scrubbing_rule"].([]interface{}) {
+		rule := r.(map[string]interface{})
+
+		ruleState := webapplicationfirewallpolicies.ScrubbingRuleEntryStateDisabled
+		if rule["enabled"].(bool) {
+			ruleState = webapplicationfirewallpolicies.ScrubbingRuleEntryStateEnabled
+		}
+
+		scrubbingRule := webapplicationfirewallpolicies.WebApplicationFirewallScrubbingRules{
+			MatchVariable:         webapplicationfirewallpolicies.ScrubbingRuleEntryMatchVariable(rule["match_variable"].(string)),
+			SelectorMatchOperator: webapplicationfirewallpolicies.ScrubbingRuleEntryMatchOperator(rule["operator"].(string)),
+			State:                 &ruleState,
+		}
+
+		if selector := rule["selector"].(string); selector != "" {
+			scrubbingRule.Selector = utils.String(selector)
+		}
+
+		rules = append(rules, scrubbingRule)
+	}
+
+	return &webapplicationfirewallpolicies.PolicySettingsLogScrubbing{
+		State:          &state,
+		ScrubbingRules: &rules,
+	}
+}
+
 func expandFrontDoorFirewallMatchConditions(input []interface{}) []webapplicationfirewallpolicies.MatchCondition {
 	if len(input) == 0 {
 		return nil
@@ -815,12 +935,62 @@ func flattenFrontDoorFirewallCustomRules(input *webapplicationfirewallpolicies.C
 			output["rate_limit_threshold"] = int(*v)
 		}
 
+		output["group_by"] = flattenFrontDoorFirewallGroupByVariables(r.GroupBy)
+
 		results = append(results, output)
 	}
 
 	return results
 }
 
+func flattenFrontDoorFirewallGroupByVariables(input *[]webapplicationfirewallpolicies.GroupByVariable) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, string(v.VariableName))
+	}
+
+	return results
+}
+
+func flattenFrontDoorFirewallLogScrubbing(input *webapplicationfirewallpolicies.PolicySettingsLogScrubbing) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	enabled := false
+	if input.State != nil {
+		enabled = *input.State == webapplicationfirewallpolicies.WebApplicationFirewallScrubbingStateEnabled
+	}
+
+	rules := make([]interface{}, 0)
+	if input.ScrubbingRules != nil {
+		for _, r := range *input.ScrubbingRules {
+			ruleEnabled := true
+			if r.State != nil {
+				ruleEnabled = *r.State == webapplicationfirewallpolicies.ScrubbingRuleEntryStateEnabled
+			}
+
+			rules = append(rules, map[string]interface{}{
+				"match_variable": string(r.MatchVariable),
+				"operator":       string(r.SelectorMatchOperator),
+				"selector":       utils.NormalizeNilableString(r.Selector),
+				"enabled":        ruleEnabled,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":        enabled,
+			"scrubbing_rule": rules,
+		},
+	}
+}
+
 func flattenFrontDoorFirewallMatchConditions(condition []webapplicationfirewallpolicies.MatchCondition) []interface{} {
 	if condition == nil {
 		return make([]interface{}, 0)
@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-04-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2024-02-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -106,6 +106,26 @@ func TestAccFrontDoorFirewallPolicy_complete(t *testing.T) {
 	})
 }
 
+func TestAccFrontDoorFirewallPolicy_premium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_frontdoor_firewall_policy", "test")
+	r := FrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.premium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("Premium_AzureFrontDoor"),
+				check.That(data.ResourceName).Key("js_challenge_cookie_expiration_in_minutes").HasValue("45"),
+				check.That(data.ResourceName).Key("log_scrubbing.0.scrubbing_rule.#").HasValue("2"),
+				check.That(data.ResourceName).Key("custom_rule.1.group_by.0").HasValue("SocketAddr"),
+				check.That(data.ResourceName).Key("managed_rule.0.override.0.rule.0.action").HasValue("AnomalyScoring"),
+				check.That(data.ResourceName).Key("managed_rule.1.override.0.rule.0.action").HasValue("JSChallenge"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (FrontDoorFirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := webapplicationfirewallpolicies.ParseFrontDoorWebApplicationFirewallPoliciesIDInsensitively(state.ID)
 	if err != nil {
@@ -350,3 +370,117 @@ resource "azurerm_frontdoor_firewall_policy" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (FrontDoorFirewallPolicyResource) premium(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "testaccRG-%d"
+  location = "%[2]s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                                      = "testAccFrontDoorWAF%[1]d"
+  resource_group_name                       = azurerm_resource_group.test.name
+  sku_name                                  = "Premium_AzureFrontDoor"
+  enabled                                   = true
+  mode                                      = "Prevention"
+  request_body_check_enabled                = false
+  js_challenge_cookie_expiration_in_minutes = 45
+
+  log_scrubbing {
+    enabled = true
+
+    scrubbing_rule {
+      match_variable = "RequestIPAddress"
+    }
+
+    scrubbing_rule {
+      match_variable = "QueryStringArgNames"
+      operator       = "Equals"
+      selector       = "token"
+    }
+  }
+
+  custom_rule {
+    name     = "Challenge"
+    priority = 1
+    type     = "MatchRule"
+    action   = "JSChallenge"
+
+    match_condition {
+      match_variable = "RequestUri"
+      operator       = "Contains"
+      match_values   = ["/login"]
+    }
+  }
+
+  custom_rule {
+    name                           = "RateLimit"
+    priority                       = 2
+    rate_limit_duration_in_minutes = 5
+    rate_limit_threshold           = 100
+    type                           = "RateLimitRule"
+    action                         = "Block"
+    group_by                       = ["SocketAddr"]
+
+    match_condition {
+      match_variable = "RequestUri"
+      operator       = "BeginsWith"
+      match_values   = ["/api"]
+    }
+  }
+
+  managed_rule {
+    type    = "Microsoft_DefaultRuleSet"
+    version = "2.1"
+
+    exclusion {
+      match_variable = "RequestBodyJsonArgNames"
+      operator       = "StartsWith"
+      selector       = "comment"
+    }
+
+    override {
+      rule_group_name = "SQLI"
+
+      exclusion {
+        match_variable = "RequestCookieNames"
+        operator       = "EqualsAny"
+        selector       = "*"
+      }
+
+      rule {
+        rule_id = "942200"
+        enabled = true
+        action  = "AnomalyScoring"
+
+        exclusion {
+          match_variable = "RequestHeaderNames"
+          operator       = "EndsWith"
+          selector       = "-trace"
+        }
+      }
+    }
+  }
+
+  managed_rule {
+    type    = "Microsoft_BotManagerRuleSet"
+    version = "1.1"
+
+    override {
+      rule_group_name = "UnknownBots"
+
+      rule {
+        rule_id = "Bot300700"
+        enabled = true
+        action  = "JSChallenge"
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
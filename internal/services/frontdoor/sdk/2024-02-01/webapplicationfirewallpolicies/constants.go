@@ -5,16 +5,20 @@ import "strings"
 type ActionType string
 
 const (
-	ActionTypeAllow    ActionType = "Allow"
-	ActionTypeBlock    ActionType = "Block"
-	ActionTypeLog      ActionType = "Log"
-	ActionTypeRedirect ActionType = "Redirect"
+	ActionTypeAllow          ActionType = "Allow"
+	ActionTypeAnomalyScoring ActionType = "AnomalyScoring"
+	ActionTypeBlock          ActionType = "Block"
+	ActionTypeJSChallenge    ActionType = "JSChallenge"
+	ActionTypeLog            ActionType = "Log"
+	ActionTypeRedirect       ActionType = "Redirect"
 )
 
 func PossibleValuesForActionType() []string {
 	return []string{
 		string(ActionTypeAllow),
+		string(ActionTypeAnomalyScoring),
 		string(ActionTypeBlock),
+		string(ActionTypeJSChallenge),
 		string(ActionTypeLog),
 		string(ActionTypeRedirect),
 	}
@@ -22,10 +26,12 @@ func PossibleValuesForActionType() []string {
 
 func parseActionType(input string) (*ActionType, error) {
 	vals := map[string]ActionType{
-		"allow":    ActionTypeAllow,
-		"block":    ActionTypeBlock,
-		"log":      ActionTypeLog,
-		"redirect": ActionTypeRedirect,
+		"allow":          ActionTypeAllow,
+		"anomalyscoring": ActionTypeAnomalyScoring,
+		"block":          ActionTypeBlock,
+		"jschallenge":    ActionTypeJSChallenge,
+		"log":            ActionTypeLog,
+		"redirect":       ActionTypeRedirect,
 	}
 	if v, ok := vals[strings.ToLower(input)]; ok {
 		return &v, nil
@@ -96,6 +102,7 @@ type ManagedRuleExclusionMatchVariable string
 
 const (
 	ManagedRuleExclusionMatchVariableQueryStringArgNames     ManagedRuleExclusionMatchVariable = "QueryStringArgNames"
+	ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames ManagedRuleExclusionMatchVariable = "RequestBodyJsonArgNames"
 	ManagedRuleExclusionMatchVariableRequestBodyPostArgNames ManagedRuleExclusionMatchVariable = "RequestBodyPostArgNames"
 	ManagedRuleExclusionMatchVariableRequestCookieNames      ManagedRuleExclusionMatchVariable = "RequestCookieNames"
 	ManagedRuleExclusionMatchVariableRequestHeaderNames      ManagedRuleExclusionMatchVariable = "RequestHeaderNames"
@@ -104,6 +111,7 @@ const (
 func PossibleValuesForManagedRuleExclusionMatchVariable() []string {
 	return []string{
 		string(ManagedRuleExclusionMatchVariableQueryStringArgNames),
+		string(ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames),
 		string(ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
 		string(ManagedRuleExclusionMatchVariableRequestCookieNames),
 		string(ManagedRuleExclusionMatchVariableRequestHeaderNames),
@@ -113,6 +121,7 @@ func PossibleValuesForManagedRuleExclusionMatchVariable() []string {
 func parseManagedRuleExclusionMatchVariable(input string) (*ManagedRuleExclusionMatchVariable, error) {
 	vals := map[string]ManagedRuleExclusionMatchVariable{
 		"querystringargnames":     ManagedRuleExclusionMatchVariableQueryStringArgNames,
+		"requestbodyjsonargnames": ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames,
 		"requestbodypostargnames": ManagedRuleExclusionMatchVariableRequestBodyPostArgNames,
 		"requestcookienames":      ManagedRuleExclusionMatchVariableRequestCookieNames,
 		"requestheadernames":      ManagedRuleExclusionMatchVariableRequestHeaderNames,
@@ -326,6 +335,34 @@ func parsePolicyMode(input string) (*PolicyMode, error) {
 	return &out, nil
 }
 
+type PolicyRequestBodyCheck string
+
+const (
+	PolicyRequestBodyCheckDisabled PolicyRequestBodyCheck = "Disabled"
+	PolicyRequestBodyCheckEnabled  PolicyRequestBodyCheck = "Enabled"
+)
+
+func PossibleValuesForPolicyRequestBodyCheck() []string {
+	return []string{
+		string(PolicyRequestBodyCheckDisabled),
+		string(PolicyRequestBodyCheckEnabled),
+	}
+}
+
+func parsePolicyRequestBodyCheck(input string) (*PolicyRequestBodyCheck, error) {
+	vals := map[string]PolicyRequestBodyCheck{
+		"disabled": PolicyRequestBodyCheckDisabled,
+		"enabled":  PolicyRequestBodyCheckEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PolicyRequestBodyCheck(input)
+	return &out, nil
+}
+
 type PolicyResourceState string
 
 const (
@@ -394,6 +431,136 @@ func parseRuleType(input string) (*RuleType, error) {
 	return &out, nil
 }
 
+type ScrubbingRuleEntryMatchOperator string
+
+const (
+	ScrubbingRuleEntryMatchOperatorEquals    ScrubbingRuleEntryMatchOperator = "Equals"
+	ScrubbingRuleEntryMatchOperatorEqualsAny ScrubbingRuleEntryMatchOperator = "EqualsAny"
+)
+
+func PossibleValuesForScrubbingRuleEntryMatchOperator() []string {
+	return []string{
+		string(ScrubbingRuleEntryMatchOperatorEquals),
+		string(ScrubbingRuleEntryMatchOperatorEqualsAny),
+	}
+}
+
+func parseScrubbingRuleEntryMatchOperator(input string) (*ScrubbingRuleEntryMatchOperator, error) {
+	vals := map[string]ScrubbingRuleEntryMatchOperator{
+		"equals":    ScrubbingRuleEntryMatchOperatorEquals,
+		"equalsany": ScrubbingRuleEntryMatchOperatorEqualsAny,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ScrubbingRuleEntryMatchOperator(input)
+	return &out, nil
+}
+
+type ScrubbingRuleEntryMatchVariable string
+
+const (
+	ScrubbingRuleEntryMatchVariableQueryStringArgNames     ScrubbingRuleEntryMatchVariable = "QueryStringArgNames"
+	ScrubbingRuleEntryMatchVariableRequestBodyJsonArgNames ScrubbingRuleEntryMatchVariable = "RequestBodyJsonArgNames"
+	ScrubbingRuleEntryMatchVariableRequestBodyPostArgNames ScrubbingRuleEntryMatchVariable = "RequestBodyPostArgNames"
+	ScrubbingRuleEntryMatchVariableRequestCookieNames      ScrubbingRuleEntryMatchVariable = "RequestCookieNames"
+	ScrubbingRuleEntryMatchVariableRequestHeaderNames      ScrubbingRuleEntryMatchVariable = "RequestHeaderNames"
+	ScrubbingRuleEntryMatchVariableRequestIPAddress        ScrubbingRuleEntryMatchVariable = "RequestIPAddress"
+	ScrubbingRuleEntryMatchVariableRequestUri              ScrubbingRuleEntryMatchVariable = "RequestUri"
+)
+
+func PossibleValuesForScrubbingRuleEntryMatchVariable() []string {
+	return []string{
+		string(ScrubbingRuleEntryMatchVariableQueryStringArgNames),
+		string(ScrubbingRuleEntryMatchVariableRequestBodyJsonArgNames),
+		string(ScrubbingRuleEntryMatchVariableRequestBodyPostArgNames),
+		string(ScrubbingRuleEntryMatchVariableRequestCookieNames),
+		string(ScrubbingRuleEntryMatchVariableRequestHeaderNames),
+		string(ScrubbingRuleEntryMatchVariableRequestIPAddress),
+		string(ScrubbingRuleEntryMatchVariableRequestUri),
+	}
+}
+
+func parseScrubbingRuleEntryMatchVariable(input string) (*ScrubbingRuleEntryMatchVariable, error) {
+	vals := map[string]ScrubbingRuleEntryMatchVariable{
+		"querystringargnames":     ScrubbingRuleEntryMatchVariableQueryStringArgNames,
+		"requestbodyjsonargnames": ScrubbingRuleEntryMatchVariableRequestBodyJsonArgNames,
+		"requestbodypostargnames": ScrubbingRuleEntryMatchVariableRequestBodyPostArgNames,
+		"requestcookienames":      ScrubbingRuleEntryMatchVariableRequestCookieNames,
+		"requestheadernames":      ScrubbingRuleEntryMatchVariableRequestHeaderNames,
+		"requestipaddress":        ScrubbingRuleEntryMatchVariableRequestIPAddress,
+		"requesturi":              ScrubbingRuleEntryMatchVariableRequestUri,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ScrubbingRuleEntryMatchVariable(input)
+	return &out, nil
+}
+
+type ScrubbingRuleEntryState string
+
+const (
+	ScrubbingRuleEntryStateDisabled ScrubbingRuleEntryState = "Disabled"
+	ScrubbingRuleEntryStateEnabled  ScrubbingRuleEntryState = "Enabled"
+)
+
+func PossibleValuesForScrubbingRuleEntryState() []string {
+	return []string{
+		string(ScrubbingRuleEntryStateDisabled),
+		string(ScrubbingRuleEntryStateEnabled),
+	}
+}
+
+func parseScrubbingRuleEntryState(input string) (*ScrubbingRuleEntryState, error) {
+	vals := map[string]ScrubbingRuleEntryState{
+		"disabled": ScrubbingRuleEntryStateDisabled,
+		"enabled":  ScrubbingRuleEntryStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ScrubbingRuleEntryState(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameClassicAzureFrontDoor  SkuName = "Classic_AzureFrontDoor"
+	SkuNamePremiumAzureFrontDoor  SkuName = "Premium_AzureFrontDoor"
+	SkuNameStandardAzureFrontDoor SkuName = "Standard_AzureFrontDoor"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameClassicAzureFrontDoor),
+		string(SkuNamePremiumAzureFrontDoor),
+		string(SkuNameStandardAzureFrontDoor),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"classic_azurefrontdoor":  SkuNameClassicAzureFrontDoor,
+		"premium_azurefrontdoor":  SkuNamePremiumAzureFrontDoor,
+		"standard_azurefrontdoor": SkuNameStandardAzureFrontDoor,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
+
 type TransformType string
 
 const (
@@ -433,3 +600,62 @@ func parseTransformType(input string) (*TransformType, error) {
 	out := TransformType(input)
 	return &out, nil
 }
+
+type VariableName string
+
+const (
+	VariableNameGeoLocation VariableName = "GeoLocation"
+	VariableNameNone        VariableName = "None"
+	VariableNameSocketAddr  VariableName = "SocketAddr"
+)
+
+func PossibleValuesForVariableName() []string {
+	return []string{
+		string(VariableNameGeoLocation),
+		string(VariableNameNone),
+		string(VariableNameSocketAddr),
+	}
+}
+
+func parseVariableName(input string) (*VariableName, error) {
+	vals := map[string]VariableName{
+		"geolocation": VariableNameGeoLocation,
+		"none":        VariableNameNone,
+		"socketaddr":  VariableNameSocketAddr,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VariableName(input)
+	return &out, nil
+}
+
+type WebApplicationFirewallScrubbingState string
+
+const (
+	WebApplicationFirewallScrubbingStateDisabled WebApplicationFirewallScrubbingState = "Disabled"
+	WebApplicationFirewallScrubbingStateEnabled  WebApplicationFirewallScrubbingState = "Enabled"
+)
+
+func PossibleValuesForWebApplicationFirewallScrubbingState() []string {
+	return []string{
+		string(WebApplicationFirewallScrubbingStateDisabled),
+		string(WebApplicationFirewallScrubbingStateEnabled),
+	}
+}
+
+func parseWebApplicationFirewallScrubbingState(input string) (*WebApplicationFirewallScrubbingState, error) {
+	vals := map[string]WebApplicationFirewallScrubbingState{
+		"disabled": WebApplicationFirewallScrubbingStateDisabled,
+		"enabled":  WebApplicationFirewallScrubbingStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebApplicationFirewallScrubbingState(input)
+	return &out, nil
+}
@@ -3,6 +3,7 @@ package webapplicationfirewallpolicies
 type CustomRule struct {
 	Action                     ActionType              `json:"action"`
 	EnabledState               *CustomRuleEnabledState `json:"enabledState,omitempty"`
+	GroupBy                    *[]GroupByVariable      `json:"groupBy,omitempty"`
 	MatchConditions            []MatchCondition        `json:"matchConditions"`
 	Name                       *string                 `json:"name,omitempty"`
 	Priority                   int64                   `json:"priority"`
@@ -0,0 +1,6 @@
+package webapplicationfirewallpolicies
+
+type PolicySettingsLogScrubbing struct {
+	ScrubbingRules *[]WebApplicationFirewallScrubbingRules `json:"scrubbingRules,omitempty"`
+	State          *WebApplicationFirewallScrubbingState   `json:"state,omitempty"`
+}
@@ -0,0 +1,8 @@
+package webapplicationfirewallpolicies
+
+type WebApplicationFirewallScrubbingRules struct {
+	MatchVariable         ScrubbingRuleEntryMatchVariable `json:"matchVariable"`
+	Selector              *string                         `json:"selector,omitempty"`
+	SelectorMatchOperator ScrubbingRuleEntryMatchOperator `json:"selectorMatchOperator"`
+	State                 *ScrubbingRuleEntryState        `json:"state,omitempty"`
+}
@@ -2,7 +2,7 @@ package webapplicationfirewallpolicies
 
 import "fmt"
 
-const defaultApiVersion = "2020-04-01"
+const defaultApiVersion = "2024-02-01"
 
 func userAgent() string {
 	return fmt.Sprintf("pandora/webapplicationfirewallpolicies/%s", defaultApiVersion)
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/sdk/2023-04-01/loadbalancers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -24,10 +25,19 @@ var (
 type BackendAddressPoolAddressResource struct{}
 
 type BackendAddressPoolAddressModel struct {
-	Name                 string `tfschema:"name"`
-	BackendAddressPoolId string `tfschema:"backend_address_pool_id"`
-	VirtualNetworkId     string `tfschema:"virtual_network_id"`
-	IPAddress            string `tfschema:"ip_address"`
+	Name                          string                           `tfschema:"name"`
+	BackendAddressPoolId          string                           `tfschema:"backend_address_pool_id"`
+	VirtualNetworkId              string                           `tfschema:"virtual_network_id"`
+	IPAddress                     string                           `tfschema:"ip_address"`
+	FrontendIPConfigurationId     string                           `tfschema:"backend_address_ip_configuration_id"`
+	AdminState                    string                           `tfschema:"admin_state"`
+	InboundNatRulePortMappingList []inboundNATRulePortMappingModel `tfschema:"inbound_nat_rule_port_mapping"`
+}
+
+type inboundNATRulePortMappingModel struct {
+	Name         string `tfschema:"inbound_nat_rule_name"`
+	FrontendPort int64  `tfschema:"frontend_port"`
+	BackendPort  int64  `tfschema:"backend_port"`
 }
 
 func (r BackendAddressPoolAddressResource) Arguments() map[string]*pluginsdk.Schema {
@@ -48,20 +58,62 @@ func (r BackendAddressPoolAddressResource) Arguments() map[string]*pluginsdk.Sch
 
 		"virtual_network_id": {
 			Type:         pluginsdk.TypeString,
-			Required:     true,
+			Optional:     true,
 			ValidateFunc: networkValidate.VirtualNetworkID,
+			ExactlyOneOf: []string{"virtual_network_id", "backend_address_ip_configuration_id"},
+			RequiredWith: []string{"ip_address"},
 		},
 
 		"ip_address": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.IsIPAddress,
+			RequiredWith:  []string{"virtual_network_id"},
+			ConflictsWith: []string{"backend_address_ip_configuration_id"},
+		},
+
+		// the Frontend IP Configuration of a regional Load Balancer, used as a Backend Address of a Global (cross-region) Load Balancer
+		"backend_address_ip_configuration_id": {
 			Type:         pluginsdk.TypeString,
-			Required:     true,
-			ValidateFunc: validation.IsIPAddress,
+			Optional:     true,
+			ValidateFunc: validate.LoadBalancerFrontendIpConfigurationID,
+			ExactlyOneOf: []string{"virtual_network_id", "backend_address_ip_configuration_id"},
+		},
+
+		"admin_state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(loadbalancers.LoadBalancerBackendAddressAdminStateNone),
+			ValidateFunc: validation.StringInSlice(loadbalancers.PossibleValuesForLoadBalancerBackendAddressAdminState(), false),
 		},
 	}
 }
 
 func (r BackendAddressPoolAddressResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"inbound_nat_rule_port_mapping": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"inbound_nat_rule_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"frontend_port": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"backend_port": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
 }
 
 func (r BackendAddressPoolAddressResource) ModelObject() interface{} {
@@ -75,7 +127,7 @@ func (r BackendAddressPoolAddressResource) ResourceType() string {
 func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.LoadBalancers.LoadBalancerBackendAddressPoolsClient
+			client := metadata.Client.LoadBalancers.BackendAddressPoolAddressesClient
 			subscriptionId := metadata.Client.Account.SubscriptionId
 
 			var model BackendAddressPoolAddressModel
@@ -104,18 +156,28 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("Backend Addresses are not supported on Basic SKU Load Balancers")
 			}
 
+			isGlobalTier := lb.Sku != nil && lb.Sku.Tier == network.LoadBalancerSkuTierGlobal
+			if isGlobalTier && model.FrontendIPConfigurationId == "" {
+				return fmt.Errorf("`backend_address_ip_configuration_id` must be specified for Backend Addresses of Global tier Load Balancers")
+			}
+			if !isGlobalTier && model.FrontendIPConfigurationId != "" {
+				return fmt.Errorf("`backend_address_ip_configuration_id` can only be specified for Backend Addresses of Global tier Load Balancers")
+			}
+
 			id := parse.NewBackendAddressPoolAddressID(subscriptionId, poolId.ResourceGroup, poolId.LoadBalancerName, poolId.BackendAddressPoolName, model.Name)
-			pool, err := client.Get(ctx, poolId.ResourceGroup, poolId.LoadBalancerName, poolId.BackendAddressPoolName)
+			sdkPoolId := loadbalancers.NewLoadBalancerBackendAddressPoolID(poolId.SubscriptionId, poolId.ResourceGroup, poolId.LoadBalancerName, poolId.BackendAddressPoolName)
+			resp, err := client.LoadBalancerBackendAddressPoolsGet(ctx, sdkPoolId)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *poolId, err)
 			}
-			if pool.BackendAddressPoolPropertiesFormat == nil {
+			if resp.Model == nil || resp.Model.Properties == nil {
 				return fmt.Errorf("retrieving %s: `properties` was nil", *poolId)
 			}
+			pool := *resp.Model
 
-			addresses := make([]network.LoadBalancerBackendAddress, 0)
-			if pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses != nil {
-				addresses = *pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses
+			addresses := make([]loadbalancers.LoadBalancerBackendAddress, 0)
+			if pool.Properties.LoadBalancerBackendAddresses != nil {
+				addresses = *pool.Properties.LoadBalancerBackendAddresses
 			}
 
 			metadata.Logger.Infof("checking for existing %s..", id)
@@ -129,26 +191,13 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 				}
 			}
 
-			addresses = append(addresses, network.LoadBalancerBackendAddress{
-				LoadBalancerBackendAddressPropertiesFormat: &network.LoadBalancerBackendAddressPropertiesFormat{
-					IPAddress: utils.String(model.IPAddress),
-					VirtualNetwork: &network.SubResource{
-						ID: utils.String(model.VirtualNetworkId),
-					},
-				},
-				Name: utils.String(id.AddressName),
-			})
-			pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses = &addresses
+			addresses = append(addresses, expandBackendAddressPoolAddress(id.AddressName, model))
+			pool.Properties.LoadBalancerBackendAddresses = &addresses
 
 			metadata.Logger.Infof("adding %s..", id)
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName, pool)
-			if err != nil {
+			if err := client.LoadBalancerBackendAddressPoolsCreateOrUpdateThenPoll(ctx, sdkPoolId, pool); err != nil {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
-			metadata.Logger.Infof("waiting for update %s..", id)
-			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for update of %s: %+v", id, err)
-			}
 			metadata.SetID(id)
 			return nil
 		},
@@ -159,23 +208,25 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.LoadBalancers.LoadBalancerBackendAddressPoolsClient
+			client := metadata.Client.LoadBalancers.BackendAddressPoolAddressesClient
 			id, err := parse.BackendAddressPoolAddressID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			pool, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+			poolId := loadbalancers.NewLoadBalancerBackendAddressPoolID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+			resp, err := client.LoadBalancerBackendAddressPoolsGet(ctx, poolId)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
-			if pool.BackendAddressPoolPropertiesFormat == nil {
+			if resp.Model == nil || resp.Model.Properties == nil {
 				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
 			}
+			pool := *resp.Model
 
-			var backendAddress *network.LoadBalancerBackendAddress
-			if pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses != nil {
-				for _, address := range *pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses {
+			var backendAddress *loadbalancers.LoadBalancerBackendAddress
+			if pool.Properties.LoadBalancerBackendAddresses != nil {
+				for _, address := range *pool.Properties.LoadBalancerBackendAddresses {
 					if address.Name == nil {
 						continue
 					}
@@ -194,16 +245,27 @@ func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 			model := BackendAddressPoolAddressModel{
 				Name:                 id.AddressName,
 				BackendAddressPoolId: backendAddressPoolId.ID(),
+				AdminState:           string(loadbalancers.LoadBalancerBackendAddressAdminStateNone),
 			}
 
-			if props := backendAddress.LoadBalancerBackendAddressPropertiesFormat; props != nil {
+			if props := backendAddress.Properties; props != nil {
 				if props.IPAddress != nil {
 					model.IPAddress = *props.IPAddress
 				}
 
-				if props.VirtualNetwork != nil && props.VirtualNetwork.ID != nil {
-					model.VirtualNetworkId = *props.VirtualNetwork.ID
+				if props.VirtualNetwork != nil && props.VirtualNetwork.Id != nil {
+					model.VirtualNetworkId = *props.VirtualNetwork.Id
 				}
+
+				if props.LoadBalancerFrontendIPConfiguration != nil && props.LoadBalancerFrontendIPConfiguration.Id != nil {
+					model.FrontendIPConfigurationId = *props.LoadBalancerFrontendIPConfiguration.Id
+				}
+
+				if props.AdminState != nil {
+					model.AdminState = string(*props.AdminState)
+				}
+
+				model.InboundNatRulePortMappingList = flattenBackendAddressPoolAddressInboundNatRulePortMapping(props.InboundNatRulesPortMapping)
 			}
 
 			return metadata.Encode(&model)
@@ -215,7 +277,7 @@ func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 func (r BackendAddressPoolAddressResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.LoadBalancers.LoadBalancerBackendAddressPoolsClient
+			client := metadata.Client.LoadBalancers.BackendAddressPoolAddressesClient
 			id, err := parse.BackendAddressPoolAddressID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
@@ -224,20 +286,22 @@ func (r BackendAddressPoolAddressResource) Delete() sdk.ResourceFunc {
 			locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
 			defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
 
-			pool, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+			poolId := loadbalancers.NewLoadBalancerBackendAddressPoolID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+			resp, err := client.LoadBalancerBackendAddressPoolsGet(ctx, poolId)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
-			if pool.BackendAddressPoolPropertiesFormat == nil {
+			if resp.Model == nil || resp.Model.Properties == nil {
 				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
 			}
+			pool := *resp.Model
 
-			addresses := make([]network.LoadBalancerBackendAddress, 0)
-			if pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses != nil {
-				addresses = *pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses
+			addresses := make([]loadbalancers.LoadBalancerBackendAddress, 0)
+			if pool.Properties.LoadBalancerBackendAddresses != nil {
+				addresses = *pool.Properties.LoadBalancerBackendAddresses
 			}
 
-			newAddresses := make([]network.LoadBalancerBackendAddress, 0)
+			newAddresses := make([]loadbalancers.LoadBalancerBackendAddress, 0)
 			for _, address := range addresses {
 				if address.Name == nil {
 					continue
@@ -247,16 +311,12 @@ func (r BackendAddressPoolAddressResource) Delete() sdk.ResourceFunc {
 					newAddresses = append(newAddresses, address)
 				}
 			}
-			pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses = &newAddresses
+			pool.Properties.LoadBalancerBackendAddresses = &newAddresses
 
 			metadata.Logger.Infof("removing %s..", *id)
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName, pool)
-			if err != nil {
+			if err := client.LoadBalancerBackendAddressPoolsCreateOrUpdateThenPoll(ctx, poolId, pool); err != nil {
 				return fmt.Errorf("removing %s: %+v", *id, err)
 			}
-			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for removal of %s: %+v", *id, err)
-			}
 			return nil
 		},
 		Timeout: 30 * time.Minute,
@@ -270,7 +330,7 @@ func (r BackendAddressPoolAddressResource) IDValidationFunc() pluginsdk.SchemaVa
 func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.LoadBalancers.LoadBalancerBackendAddressPoolsClient
+			client := metadata.Client.LoadBalancers.BackendAddressPoolAddressesClient
 			id, err := parse.BackendAddressPoolAddressID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
@@ -284,17 +344,19 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			pool, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+			poolId := loadbalancers.NewLoadBalancerBackendAddressPoolID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+			resp, err := client.LoadBalancerBackendAddressPoolsGet(ctx, poolId)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
-			if pool.BackendAddressPoolPropertiesFormat == nil {
+			if resp.Model == nil || resp.Model.Properties == nil {
 				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
 			}
+			pool := *resp.Model
 
-			addresses := make([]network.LoadBalancerBackendAddress, 0)
-			if pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses != nil {
-				addresses = *pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses
+			addresses := make([]loadbalancers.LoadBalancerBackendAddress, 0)
+			if pool.Properties.LoadBalancerBackendAddresses != nil {
+				addresses = *pool.Properties.LoadBalancerBackendAddresses
 			}
 			index := -1
 			for i, address := range addresses {
@@ -311,26 +373,60 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("%s was not found", *id)
 			}
 
-			addresses[index] = network.LoadBalancerBackendAddress{
-				LoadBalancerBackendAddressPropertiesFormat: &network.LoadBalancerBackendAddressPropertiesFormat{
-					IPAddress: utils.String(model.IPAddress),
-					VirtualNetwork: &network.SubResource{
-						ID: utils.String(model.VirtualNetworkId),
-					},
-				},
-				Name: utils.String(id.AddressName),
-			}
-			pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses = &addresses
+			addresses[index] = expandBackendAddressPoolAddress(id.AddressName, model)
+			pool.Properties.LoadBalancerBackendAddresses = &addresses
 
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName, pool)
-			if err != nil {
+			if err := client.LoadBalancerBackendAddressPoolsCreateOrUpdateThenPoll(ctx, poolId, pool); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
-			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
-			}
 			return nil
 		},
 		Timeout: 30 * time.Minute,
 	}
 }
+
+func expandBackendAddressPoolAddress(name string, model BackendAddressPoolAddressModel) loadbalancers.LoadBalancerBackendAddress {
+	adminState := loadbalancers.LoadBalancerBackendAddressAdminState(model.AdminState)
+	props := loadbalancers.LoadBalancerBackendAddressPropertiesFormat{
+		AdminState: &adminState,
+	}
+
+	if model.FrontendIPConfigurationId != "" {
+		props.LoadBalancerFrontendIPConfiguration = &loadbalancers.SubResource{
+			Id: utils.String(model.FrontendIPConfigurationId),
+		}
+	} else {
+		props.IPAddress = utils.String(model.IPAddress)
+		props.VirtualNetwork = &loadbalancers.SubResource{
+			Id: utils.String(model.VirtualNetworkId),
+		}
+	}
+
+	return loadbalancers.LoadBalancerBackendAddress{
+		Properties: &props,
+		Name:       utils.String(name),
+	}
+}
+
+func flattenBackendAddressPoolAddressInboundNatRulePortMapping(input *[]loadbalancers.NatRulePortMapping) []inboundNATRulePortMappingModel {
+	output := make([]inboundNATRulePortMappingModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		mapping := inboundNATRulePortMappingModel{}
+		if v.InboundNatRuleName != nil {
+			mapping.Name = *v.InboundNatRuleName
+		}
+		if v.FrontendPort != nil {
+			mapping.FrontendPort = *v.FrontendPort
+		}
+		if v.BackendPort != nil {
+			mapping.BackendPort = *v.BackendPort
+		}
+		output = append(output, mapping)
+	}
+
+	return output
+}
@@ -73,6 +73,22 @@ func TestAccBackendAddressPoolAddressUpdate(t *testing.T) {
 			Config: r.update(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("admin_state").HasValue("Down"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBackendAddressPoolAddressCrossRegion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lb_backend_address_pool_address", "test")
+	r := BackendAddressPoolAddressResourceTests{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossRegion(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("backend_address_ip_configuration_id").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -222,10 +238,55 @@ resource "azurerm_lb_backend_address_pool_address" "test" {
   backend_address_pool_id = azurerm_lb_backend_address_pool.test.id
   virtual_network_id      = azurerm_virtual_network.test.id
   ip_address              = "191.168.0.2"
+  admin_state             = "Down"
 }
 `, template)
 }
 
+func (t BackendAddressPoolAddressResourceTests) crossRegion(data acceptance.TestData) string {
+	template := t.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_public_ip" "global" {
+  name                = "acctestpip-global-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+  sku_tier            = "Global"
+}
+
+resource "azurerm_lb" "global" {
+  name                = "acctestlb-global-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+  sku_tier            = "Global"
+
+  frontend_ip_configuration {
+    name                 = "feip"
+    public_ip_address_id = azurerm_public_ip.global.id
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "global" {
+  name            = "global"
+  loadbalancer_id = azurerm_lb.global.id
+}
+
+resource "azurerm_lb_backend_address_pool_address" "test" {
+  name                                = "address"
+  backend_address_pool_id             = azurerm_lb_backend_address_pool.global.id
+  backend_address_ip_configuration_id = azurerm_lb.test.frontend_ip_configuration.0.id
+}
+`, template, data.RandomInteger)
+}
+
 func (BackendAddressPoolAddressResourceTests) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
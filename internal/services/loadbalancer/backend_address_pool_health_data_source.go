@@ -0,0 +1,190 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/sdk/2023-04-01/loadbalancers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceArmLoadBalancerBackendAddressPoolHealth() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmLoadBalancerBackendAddressPoolHealthRead,
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"backend_address_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LoadBalancerBackendAddressPoolID,
+			},
+
+			"load_balancing_rule": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"up_count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"down_count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"backend_address": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"ip_address": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"network_interface_ip_configuration_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"state": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"reason": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerBackendAddressPoolHealthRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancers.LoadBalancerBackendAddressPoolsClient
+	healthClient := meta.(*clients.Client).LoadBalancers.LoadBalancingRulesHealthClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LoadBalancerBackendAddressPoolID(d.Get("backend_address_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the health of the backend addresses is reported per Load Balancing Rule, since each Rule can use a different Probe
+	loadBalancingRules := make([]interface{}, 0)
+	if props := resp.BackendAddressPoolPropertiesFormat; props != nil && props.LoadBalancingRules != nil {
+		for _, rule := range *props.LoadBalancingRules {
+			if rule.ID == nil {
+				continue
+			}
+
+			ruleId, err := loadbalancers.ParseLoadBalancingRuleIDInsensitively(*rule.ID)
+			if err != nil {
+				return err
+			}
+
+			health, err := healthClient.LoadBalancerLoadBalancingRulesHealth(ctx, *ruleId)
+			if err != nil {
+				return fmt.Errorf("retrieving the health of %s: %+v", *ruleId, err)
+			}
+
+			loadBalancingRules = append(loadBalancingRules, flattenArmLoadBalancerRuleHealth(*ruleId, health.Model))
+		}
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("load_balancing_rule", loadBalancingRules); err != nil {
+		return fmt.Errorf("setting `load_balancing_rule`: %v", err)
+	}
+
+	return nil
+}
+
+func flattenArmLoadBalancerRuleHealth(id loadbalancers.LoadBalancingRuleId, input *loadbalancers.LoadBalancerHealthPerRule) map[string]interface{} {
+	upCount := 0
+	downCount := 0
+	backendAddresses := make([]interface{}, 0)
+	if input != nil {
+		if input.Up != nil {
+			upCount = int(*input.Up)
+		}
+		if input.Down != nil {
+			downCount = int(*input.Down)
+		}
+
+		if input.LoadBalancerBackendAddresses != nil {
+			for _, v := range *input.LoadBalancerBackendAddresses {
+				ipAddress := ""
+				if v.IPAddress != nil {
+					ipAddress = *v.IPAddress
+				}
+
+				ipConfigurationId := ""
+				if v.NetworkInterfaceIPConfigurationId != nil {
+					ipConfigurationId = *v.NetworkInterfaceIPConfigurationId
+				}
+
+				state := ""
+				if v.State != nil {
+					state = *v.State
+				}
+
+				reason := ""
+				if v.Reason != nil {
+					reason = *v.Reason
+				}
+
+				backendAddresses = append(backendAddresses, map[string]interface{}{
+					"ip_address":                            ipAddress,
+					"network_interface_ip_configuration_id": ipConfigurationId,
+					"state":                                 state,
+					"reason":                                reason,
+				})
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"id":              id.ID(),
+		"name":            id.LoadBalancingRuleName,
+		"up_count":        upCount,
+		"down_count":      downCount,
+		"backend_address": backendAddresses,
+	}
+}
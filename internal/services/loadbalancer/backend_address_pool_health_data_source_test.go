@@ -0,0 +1,63 @@
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LoadBalancerBackendAddressPoolHealthDataSource struct{}
+
+func TestAccDataSourceBackendAddressPoolHealth_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_lb_backend_address_pool_health", "test")
+	r := LoadBalancerBackendAddressPoolHealthDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("load_balancing_rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("load_balancing_rule.0.id").Exists(),
+				check.That(data.ResourceName).Key("load_balancing_rule.0.name").Exists(),
+				check.That(data.ResourceName).Key("load_balancing_rule.0.up_count").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (LoadBalancerBackendAddressPoolHealthDataSource) basic(data acceptance.TestData) string {
+	template := LoadBalancerRule{}.template(data, "Standard")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  name            = "pool-%[2]d"
+  loadbalancer_id = azurerm_lb.test.id
+}
+
+resource "azurerm_lb_probe" "test" {
+  name            = "probe-%[2]d"
+  loadbalancer_id = azurerm_lb.test.id
+  port            = 22
+}
+
+resource "azurerm_lb_rule" "test" {
+  name                           = "LbRule-%[2]d"
+  loadbalancer_id                = azurerm_lb.test.id
+  frontend_ip_configuration_name = azurerm_lb.test.frontend_ip_configuration.0.name
+  protocol                       = "Tcp"
+  frontend_port                  = 22
+  backend_port                   = 22
+  backend_address_pool_ids       = [azurerm_lb_backend_address_pool.test.id]
+  probe_id                       = azurerm_lb_probe.test.id
+}
+
+data "azurerm_lb_backend_address_pool_health" "test" {
+  backend_address_pool_id = azurerm_lb_backend_address_pool.test.id
+
+  depends_on = [azurerm_lb_rule.test]
+}
+`, template, data.RandomInteger)
+}
@@ -113,6 +113,14 @@ func resourceArmLoadBalancerBackendAddressPool() *pluginsdk.Resource {
 					},
 				},
 
+				"inbound_nat_rules": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
 				"load_balancing_rules": {
 					Type:     pluginsdk.TypeList,
 					Computed: true,
@@ -342,6 +350,19 @@ func resourceArmLoadBalancerBackendAddressPoolRead(d *pluginsdk.ResourceData, me
 			return fmt.Errorf("setting `backend_ip_configurations`: %v", err)
 		}
 
+		var inboundNatRules []string
+		if rules := props.InboundNatRules; rules != nil {
+			for _, rule := range *rules {
+				if rule.ID == nil {
+					continue
+				}
+				inboundNatRules = append(inboundNatRules, *rule.ID)
+			}
+		}
+		if err := d.Set("inbound_nat_rules", inboundNatRules); err != nil {
+			return fmt.Errorf("setting `inbound_nat_rules`: %v", err)
+		}
+
 		var loadBalancingRules []string
 		if rules := props.LoadBalancingRules; rules != nil {
 			for _, rule := range *rules {
@@ -3,12 +3,15 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/sdk/2023-04-01/loadbalancers"
 )
 
 type Client struct {
 	LoadBalancersClient                   *network.LoadBalancersClient
 	LoadBalancerBackendAddressPoolsClient *network.LoadBalancerBackendAddressPoolsClient
+	BackendAddressPoolAddressesClient     *loadbalancers.LoadBalancersClient
 	LoadBalancingRulesClient              *network.LoadBalancerLoadBalancingRulesClient
+	LoadBalancingRulesHealthClient        *loadbalancers.LoadBalancersClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,12 +21,20 @@ func NewClient(o *common.ClientOptions) *Client {
 	loadBalancerBackendAddressPoolsClient := network.NewLoadBalancerBackendAddressPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&loadBalancerBackendAddressPoolsClient.Client, o.ResourceManagerAuthorizer)
 
+	backendAddressPoolAddressesClient := loadbalancers.NewLoadBalancersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&backendAddressPoolAddressesClient.Client, o.ResourceManagerAuthorizer)
+
 	loadBalancingRulesClient := network.NewLoadBalancerLoadBalancingRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&loadBalancingRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	loadBalancingRulesHealthClient := loadbalancers.NewLoadBalancersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&loadBalancingRulesHealthClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		LoadBalancersClient:                   &loadBalancersClient,
 		LoadBalancerBackendAddressPoolsClient: &loadBalancerBackendAddressPoolsClient,
+		BackendAddressPoolAddressesClient:     &backendAddressPoolAddressesClient,
 		LoadBalancingRulesClient:              &loadBalancingRulesClient,
+		LoadBalancingRulesHealthClient:        &loadBalancingRulesHealthClient,
 	}
 }
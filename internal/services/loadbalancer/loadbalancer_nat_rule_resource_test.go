@@ -149,6 +149,23 @@ func TestAccAzureRMLoadBalancerNatRule_zeroPortNumber(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLoadBalancerNatRule_backendAddressPoolPortRange(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lb_nat_rule", "test")
+	r := LoadBalancerNatRule{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.backendAddressPoolPortRange(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("frontend_port_start").HasValue("3000"),
+				check.That(data.ResourceName).Key("frontend_port_end").HasValue("3389"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r LoadBalancerNatRule) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.LoadBalancerInboundNatRuleID(state.ID)
 	if err != nil {
@@ -415,3 +432,27 @@ resource "azurerm_lb_nat_rule" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r LoadBalancerNatRule) backendAddressPoolPortRange(data acceptance.TestData) string {
+	template := r.template(data, "Standard")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  name            = "pool-%[2]d"
+  loadbalancer_id = azurerm_lb.test.id
+}
+
+resource "azurerm_lb_nat_rule" "test" {
+  resource_group_name            = azurerm_resource_group.test.name
+  loadbalancer_id                = azurerm_lb.test.id
+  name                           = "NatRule-%[2]d"
+  protocol                       = "Tcp"
+  frontend_port_start            = 3000
+  frontend_port_end              = 3389
+  backend_port                   = 3389
+  backend_address_pool_id        = azurerm_lb_backend_address_pool.test.id
+  frontend_ip_configuration_name = azurerm_lb.test.frontend_ip_configuration.0.name
+}
+`, template, data.RandomInteger)
+}
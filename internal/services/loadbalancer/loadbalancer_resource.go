@@ -100,6 +100,13 @@ func resourceArmLoadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		if !strings.EqualFold(d.Get("sku").(string), string(network.LoadBalancerSkuNameStandard)) {
 			return fmt.Errorf("global load balancing is only supported for standard SKU load balancers")
 		}
+
+		// a cross-region Load Balancer distributes traffic to regional Load Balancers, so can only be public facing
+		for _, raw := range d.Get("frontend_ip_configuration").([]interface{}) {
+			if v, ok := raw.(map[string]interface{}); ok && v["subnet_id"].(string) != "" {
+				return fmt.Errorf("`subnet_id` cannot be specified within `frontend_ip_configuration` for global load balancers")
+			}
+		}
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
@@ -74,9 +74,35 @@ func resourceArmLoadBalancerNatRule() *pluginsdk.Resource {
 			},
 
 			"frontend_port": {
+				Type:          pluginsdk.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validate.PortNumberOrZero,
+				ExactlyOneOf:  []string{"frontend_port", "frontend_port_start"},
+				ConflictsWith: []string{"frontend_port_end", "backend_address_pool_id"},
+			},
+
+			"frontend_port_start": {
 				Type:         pluginsdk.TypeInt,
-				Required:     true,
-				ValidateFunc: validate.PortNumberOrZero,
+				Optional:     true,
+				ValidateFunc: validate.PortNumber,
+				RequiredWith: []string{"frontend_port_end", "backend_address_pool_id"},
+				ExactlyOneOf: []string{"frontend_port", "frontend_port_start"},
+			},
+
+			"frontend_port_end": {
+				Type:          pluginsdk.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validate.PortNumber,
+				RequiredWith:  []string{"frontend_port_start", "backend_address_pool_id"},
+				ConflictsWith: []string{"frontend_port"},
+			},
+
+			"backend_address_pool_id": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  loadBalancerValidate.LoadBalancerBackendAddressPoolID,
+				RequiredWith:  []string{"frontend_port_start", "frontend_port_end"},
+				ConflictsWith: []string{"frontend_port"},
 			},
 
 			"backend_port": {
@@ -250,6 +276,24 @@ func resourceArmLoadBalancerNatRuleRead(d *pluginsdk.ResourceData, meta interfac
 		}
 		d.Set("frontend_port", frontendPort)
 
+		frontendPortRangeStart := 0
+		if props.FrontendPortRangeStart != nil {
+			frontendPortRangeStart = int(*props.FrontendPortRangeStart)
+		}
+		d.Set("frontend_port_start", frontendPortRangeStart)
+
+		frontendPortRangeEnd := 0
+		if props.FrontendPortRangeEnd != nil {
+			frontendPortRangeEnd = int(*props.FrontendPortRangeEnd)
+		}
+		d.Set("frontend_port_end", frontendPortRangeEnd)
+
+		backendAddressPoolId := ""
+		if props.BackendAddressPool != nil && props.BackendAddressPool.ID != nil {
+			backendAddressPoolId = *props.BackendAddressPool.ID
+		}
+		d.Set("backend_address_pool_id", backendAddressPoolId)
+
 		idleTimeoutInMinutes := 0
 		if props.IdleTimeoutInMinutes != nil {
 			idleTimeoutInMinutes = int(*props.IdleTimeoutInMinutes)
@@ -308,11 +352,26 @@ func resourceArmLoadBalancerNatRuleDelete(d *pluginsdk.ResourceData, meta interf
 func expandAzureRmLoadBalancerNatRule(d *pluginsdk.ResourceData, lb *network.LoadBalancer, loadBalancerId parse.LoadBalancerId) (*network.InboundNatRule, error) {
 	properties := network.InboundNatRulePropertiesFormat{
 		Protocol:       network.TransportProtocol(d.Get("protocol").(string)),
-		FrontendPort:   utils.Int32(int32(d.Get("frontend_port").(int))),
 		BackendPort:    utils.Int32(int32(d.Get("backend_port").(int))),
 		EnableTCPReset: utils.Bool(d.Get("enable_tcp_reset").(bool)),
 	}
 
+	// when a Backend Address Pool is specified a port mapping is created for each Backend Address within the Pool,
+	// using a Frontend Port from the specified range - rather than a single Frontend Port
+	if v := d.Get("backend_address_pool_id").(string); v != "" {
+		if d.Get("frontend_port_start").(int) > d.Get("frontend_port_end").(int) {
+			return nil, fmt.Errorf("`frontend_port_start` must be less than or equal to `frontend_port_end`")
+		}
+
+		properties.BackendAddressPool = &network.SubResource{
+			ID: utils.String(v),
+		}
+		properties.FrontendPortRangeStart = utils.Int32(int32(d.Get("frontend_port_start").(int)))
+		properties.FrontendPortRangeEnd = utils.Int32(int32(d.Get("frontend_port_end").(int)))
+	} else {
+		properties.FrontendPort = utils.Int32(int32(d.Get("frontend_port").(int)))
+	}
+
 	if v, ok := d.GetOk("enable_floating_ip"); ok {
 		properties.EnableFloatingIP = utils.Bool(v.(bool))
 	}
@@ -0,0 +1,15 @@
+package loadbalancers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LoadBalancersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLoadBalancersClientWithBaseURI(endpoint string) LoadBalancersClient {
+	return LoadBalancersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,158 @@
+package loadbalancers
+
+import "strings"
+
+type GatewayLoadBalancerTunnelInterfaceType string
+
+const (
+	GatewayLoadBalancerTunnelInterfaceTypeExternal GatewayLoadBalancerTunnelInterfaceType = "External"
+	GatewayLoadBalancerTunnelInterfaceTypeInternal GatewayLoadBalancerTunnelInterfaceType = "Internal"
+	GatewayLoadBalancerTunnelInterfaceTypeNone     GatewayLoadBalancerTunnelInterfaceType = "None"
+)
+
+func PossibleValuesForGatewayLoadBalancerTunnelInterfaceType() []string {
+	return []string{
+		string(GatewayLoadBalancerTunnelInterfaceTypeExternal),
+		string(GatewayLoadBalancerTunnelInterfaceTypeInternal),
+		string(GatewayLoadBalancerTunnelInterfaceTypeNone),
+	}
+}
+
+func parseGatewayLoadBalancerTunnelInterfaceType(input string) (*GatewayLoadBalancerTunnelInterfaceType, error) {
+	vals := map[string]GatewayLoadBalancerTunnelInterfaceType{
+		"external": GatewayLoadBalancerTunnelInterfaceTypeExternal,
+		"internal": GatewayLoadBalancerTunnelInterfaceTypeInternal,
+		"none":     GatewayLoadBalancerTunnelInterfaceTypeNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := GatewayLoadBalancerTunnelInterfaceType(input)
+	return &out, nil
+}
+
+type GatewayLoadBalancerTunnelProtocol string
+
+const (
+	GatewayLoadBalancerTunnelProtocolNative GatewayLoadBalancerTunnelProtocol = "Native"
+	GatewayLoadBalancerTunnelProtocolNone   GatewayLoadBalancerTunnelProtocol = "None"
+	GatewayLoadBalancerTunnelProtocolVXLAN  GatewayLoadBalancerTunnelProtocol = "VXLAN"
+)
+
+func PossibleValuesForGatewayLoadBalancerTunnelProtocol() []string {
+	return []string{
+		string(GatewayLoadBalancerTunnelProtocolNative),
+		string(GatewayLoadBalancerTunnelProtocolNone),
+		string(GatewayLoadBalancerTunnelProtocolVXLAN),
+	}
+}
+
+func parseGatewayLoadBalancerTunnelProtocol(input string) (*GatewayLoadBalancerTunnelProtocol, error) {
+	vals := map[string]GatewayLoadBalancerTunnelProtocol{
+		"native": GatewayLoadBalancerTunnelProtocolNative,
+		"none":   GatewayLoadBalancerTunnelProtocolNone,
+		"vxlan":  GatewayLoadBalancerTunnelProtocolVXLAN,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := GatewayLoadBalancerTunnelProtocol(input)
+	return &out, nil
+}
+
+type LoadBalancerBackendAddressAdminState string
+
+const (
+	LoadBalancerBackendAddressAdminStateDown LoadBalancerBackendAddressAdminState = "Down"
+	LoadBalancerBackendAddressAdminStateNone LoadBalancerBackendAddressAdminState = "None"
+	LoadBalancerBackendAddressAdminStateUp   LoadBalancerBackendAddressAdminState = "Up"
+)
+
+func PossibleValuesForLoadBalancerBackendAddressAdminState() []string {
+	return []string{
+		string(LoadBalancerBackendAddressAdminStateDown),
+		string(LoadBalancerBackendAddressAdminStateNone),
+		string(LoadBalancerBackendAddressAdminStateUp),
+	}
+}
+
+func parseLoadBalancerBackendAddressAdminState(input string) (*LoadBalancerBackendAddressAdminState, error) {
+	vals := map[string]LoadBalancerBackendAddressAdminState{
+		"down": LoadBalancerBackendAddressAdminStateDown,
+		"none": LoadBalancerBackendAddressAdminStateNone,
+		"up":   LoadBalancerBackendAddressAdminStateUp,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LoadBalancerBackendAddressAdminState(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type SyncMode string
+
+const (
+	SyncModeAutomatic SyncMode = "Automatic"
+	SyncModeManual    SyncMode = "Manual"
+)
+
+func PossibleValuesForSyncMode() []string {
+	return []string{
+		string(SyncModeAutomatic),
+		string(SyncModeManual),
+	}
+}
+
+func parseSyncMode(input string) (*SyncMode, error) {
+	vals := map[string]SyncMode{
+		"automatic": SyncModeAutomatic,
+		"manual":    SyncModeManual,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SyncMode(input)
+	return &out, nil
+}
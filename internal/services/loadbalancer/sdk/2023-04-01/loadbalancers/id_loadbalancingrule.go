@@ -0,0 +1,137 @@
+package loadbalancers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = LoadBalancingRuleId{}
+
+// LoadBalancingRuleId is a struct representing the Resource ID for a Load Balancing Rule
+type LoadBalancingRuleId struct {
+	SubscriptionId        string
+	ResourceGroupName     string
+	LoadBalancerName      string
+	LoadBalancingRuleName string
+}
+
+// NewLoadBalancingRuleID returns a new LoadBalancingRuleId struct
+func NewLoadBalancingRuleID(subscriptionId string, resourceGroupName string, loadBalancerName string, loadBalancingRuleName string) LoadBalancingRuleId {
+	return LoadBalancingRuleId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroupName:     resourceGroupName,
+		LoadBalancerName:      loadBalancerName,
+		LoadBalancingRuleName: loadBalancingRuleName,
+	}
+}
+
+// ParseLoadBalancingRuleID parses 'input' into a LoadBalancingRuleId
+func ParseLoadBalancingRuleID(input string) (*LoadBalancingRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LoadBalancingRuleId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LoadBalancingRuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.LoadBalancerName, ok = parsed.Parsed["loadBalancerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'loadBalancerName' was not found in the resource id %q", input)
+	}
+
+	if id.LoadBalancingRuleName, ok = parsed.Parsed["loadBalancingRuleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'loadBalancingRuleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseLoadBalancingRuleIDInsensitively parses 'input' case-insensitively into a LoadBalancingRuleId
+// note: this method should only be used for API response data and not user input
+func ParseLoadBalancingRuleIDInsensitively(input string) (*LoadBalancingRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LoadBalancingRuleId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LoadBalancingRuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.LoadBalancerName, ok = parsed.Parsed["loadBalancerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'loadBalancerName' was not found in the resource id %q", input)
+	}
+
+	if id.LoadBalancingRuleName, ok = parsed.Parsed["loadBalancingRuleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'loadBalancingRuleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateLoadBalancingRuleID checks that 'input' can be parsed as a Load Balancing Rule ID
+func ValidateLoadBalancingRuleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseLoadBalancingRuleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Load Balancing Rule ID
+func (id LoadBalancingRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/loadBalancingRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.LoadBalancerName, id.LoadBalancingRuleName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Load Balancing Rule ID
+func (id LoadBalancingRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("subscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("resourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("providers", "providers", "providers"),
+		resourceids.ResourceProviderSegment("microsoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("loadBalancers", "loadBalancers", "loadBalancers"),
+		resourceids.UserSpecifiedSegment("loadBalancerName", "loadBalancerValue"),
+		resourceids.StaticSegment("loadBalancingRules", "loadBalancingRules", "loadBalancingRules"),
+		resourceids.UserSpecifiedSegment("loadBalancingRuleName", "loadBalancingRuleValue"),
+	}
+}
+
+// String returns a human-readable description of this Load Balancing Rule ID
+func (id LoadBalancingRuleId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Load Balancer Name: %q", id.LoadBalancerName),
+		fmt.Sprintf("Load Balancing Rule Name: %q", id.LoadBalancingRuleName),
+	}
+	return fmt.Sprintf("Load Balancing Rule (%s)", strings.Join(components, "\n"))
+}
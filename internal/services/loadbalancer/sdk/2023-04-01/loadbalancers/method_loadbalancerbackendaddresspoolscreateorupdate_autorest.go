@@ -0,0 +1,75 @@
+package loadbalancers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type LoadBalancerBackendAddressPoolsCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// LoadBalancerBackendAddressPoolsCreateOrUpdate ...
+func (c LoadBalancersClient) LoadBalancerBackendAddressPoolsCreateOrUpdate(ctx context.Context, id LoadBalancerBackendAddressPoolId, input BackendAddressPool) (result LoadBalancerBackendAddressPoolsCreateOrUpdateOperationResponse, err error) {
+	req, err := c.preparerForLoadBalancerBackendAddressPoolsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerBackendAddressPoolsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForLoadBalancerBackendAddressPoolsCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerBackendAddressPoolsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// LoadBalancerBackendAddressPoolsCreateOrUpdateThenPoll performs LoadBalancerBackendAddressPoolsCreateOrUpdate then polls until it's completed
+func (c LoadBalancersClient) LoadBalancerBackendAddressPoolsCreateOrUpdateThenPoll(ctx context.Context, id LoadBalancerBackendAddressPoolId, input BackendAddressPool) error {
+	result, err := c.LoadBalancerBackendAddressPoolsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing LoadBalancerBackendAddressPoolsCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after LoadBalancerBackendAddressPoolsCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForLoadBalancerBackendAddressPoolsCreateOrUpdate prepares the LoadBalancerBackendAddressPoolsCreateOrUpdate request.
+func (c LoadBalancersClient) preparerForLoadBalancerBackendAddressPoolsCreateOrUpdate(ctx context.Context, id LoadBalancerBackendAddressPoolId, input BackendAddressPool) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForLoadBalancerBackendAddressPoolsCreateOrUpdate sends the LoadBalancerBackendAddressPoolsCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c LoadBalancersClient) senderForLoadBalancerBackendAddressPoolsCreateOrUpdate(ctx context.Context, req *http.Request) (future LoadBalancerBackendAddressPoolsCreateOrUpdateOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,64 @@
+package loadbalancers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type LoadBalancerBackendAddressPoolsGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *BackendAddressPool
+}
+
+// LoadBalancerBackendAddressPoolsGet ...
+func (c LoadBalancersClient) LoadBalancerBackendAddressPoolsGet(ctx context.Context, id LoadBalancerBackendAddressPoolId) (result LoadBalancerBackendAddressPoolsGetOperationResponse, err error) {
+	req, err := c.preparerForLoadBalancerBackendAddressPoolsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerBackendAddressPoolsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerBackendAddressPoolsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForLoadBalancerBackendAddressPoolsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerBackendAddressPoolsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForLoadBalancerBackendAddressPoolsGet prepares the LoadBalancerBackendAddressPoolsGet request.
+func (c LoadBalancersClient) preparerForLoadBalancerBackendAddressPoolsGet(ctx context.Context, id LoadBalancerBackendAddressPoolId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForLoadBalancerBackendAddressPoolsGet handles the response to the LoadBalancerBackendAddressPoolsGet request. The method always
+// closes the http.Response Body.
+func (c LoadBalancersClient) responderForLoadBalancerBackendAddressPoolsGet(resp *http.Response) (result LoadBalancerBackendAddressPoolsGetOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,86 @@
+package loadbalancers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type LoadBalancerLoadBalancingRulesHealthOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *LoadBalancerHealthPerRule
+}
+
+// LoadBalancerLoadBalancingRulesHealth performs LoadBalancerLoadBalancingRulesHealth then polls until it's completed,
+// returning the health of each of the backend addresses for this Load Balancing Rule
+func (c LoadBalancersClient) LoadBalancerLoadBalancingRulesHealth(ctx context.Context, id LoadBalancingRuleId) (result LoadBalancerLoadBalancingRulesHealthOperationResponse, err error) {
+	req, err := c.preparerForLoadBalancerLoadBalancingRulesHealth(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerLoadBalancingRulesHealth", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerLoadBalancingRulesHealth", resp, "Failure sending request")
+		return
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		result.HttpResponse = resp
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerLoadBalancingRulesHealth", resp, "Failure sending request")
+		return
+	}
+
+	if err = future.WaitForCompletionRef(ctx, c.Client); err != nil {
+		result.HttpResponse = future.Response()
+		err = fmt.Errorf("polling after LoadBalancerLoadBalancingRulesHealth: %+v", err)
+		return
+	}
+
+	// the result is available from the Location returned by the initial request, once the operation has completed
+	resp, err = future.GetResult(c.Client)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerLoadBalancingRulesHealth", resp, "Failure retrieving result")
+		return
+	}
+
+	result, err = c.responderForLoadBalancerLoadBalancingRulesHealth(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadbalancers.LoadBalancersClient", "LoadBalancerLoadBalancingRulesHealth", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForLoadBalancerLoadBalancingRulesHealth prepares the LoadBalancerLoadBalancingRulesHealth request.
+func (c LoadBalancersClient) preparerForLoadBalancerLoadBalancingRulesHealth(ctx context.Context, id LoadBalancingRuleId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/health", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForLoadBalancerLoadBalancingRulesHealth handles the response to the LoadBalancerLoadBalancingRulesHealth request. The method always
+// closes the http.Response Body.
+func (c LoadBalancersClient) responderForLoadBalancerLoadBalancingRulesHealth(resp *http.Response) (result LoadBalancerLoadBalancingRulesHealthOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,9 @@
+package loadbalancers
+
+type BackendAddressPool struct {
+	Etag       *string                             `json:"etag,omitempty"`
+	Id         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Properties *BackendAddressPoolPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                             `json:"type,omitempty"`
+}
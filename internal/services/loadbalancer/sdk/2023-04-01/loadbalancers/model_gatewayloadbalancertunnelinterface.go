@@ -0,0 +1,8 @@
+package loadbalancers
+
+type GatewayLoadBalancerTunnelInterface struct {
+	Identifier *int64                                  `json:"identifier,omitempty"`
+	Port       *int64                                  `json:"port,omitempty"`
+	Protocol   *GatewayLoadBalancerTunnelProtocol      `json:"protocol,omitempty"`
+	Type       *GatewayLoadBalancerTunnelInterfaceType `json:"type,omitempty"`
+}
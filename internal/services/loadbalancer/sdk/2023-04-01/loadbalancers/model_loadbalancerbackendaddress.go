@@ -0,0 +1,6 @@
+package loadbalancers
+
+type LoadBalancerBackendAddress struct {
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *LoadBalancerBackendAddressPropertiesFormat `json:"properties,omitempty"`
+}
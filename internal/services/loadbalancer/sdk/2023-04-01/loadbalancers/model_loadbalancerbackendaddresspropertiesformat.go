@@ -0,0 +1,11 @@
+package loadbalancers
+
+type LoadBalancerBackendAddressPropertiesFormat struct {
+	AdminState                          *LoadBalancerBackendAddressAdminState `json:"adminState,omitempty"`
+	IPAddress                           *string                               `json:"ipAddress,omitempty"`
+	InboundNatRulesPortMapping          *[]NatRulePortMapping                 `json:"inboundNatRulesPortMapping,omitempty"`
+	LoadBalancerFrontendIPConfiguration *SubResource                          `json:"loadBalancerFrontendIPConfiguration,omitempty"`
+	NetworkInterfaceIPConfiguration     *SubResource                          `json:"networkInterfaceIPConfiguration,omitempty"`
+	Subnet                              *SubResource                          `json:"subnet,omitempty"`
+	VirtualNetwork                      *SubResource                          `json:"virtualNetwork,omitempty"`
+}
@@ -0,0 +1,7 @@
+package loadbalancers
+
+type LoadBalancerHealthPerRule struct {
+	Down                         *int64                                        `json:"down,omitempty"`
+	LoadBalancerBackendAddresses *[]LoadBalancerHealthPerRulePerBackendAddress `json:"loadBalancerBackendAddresses,omitempty"`
+	Up                           *int64                                        `json:"up,omitempty"`
+}
@@ -0,0 +1,8 @@
+package loadbalancers
+
+type LoadBalancerHealthPerRulePerBackendAddress struct {
+	IPAddress                         *string `json:"ipAddress,omitempty"`
+	NetworkInterfaceIPConfigurationId *string `json:"networkInterfaceIPConfigurationId,omitempty"`
+	Reason                            *string `json:"reason,omitempty"`
+	State                             *string `json:"state,omitempty"`
+}
@@ -0,0 +1,7 @@
+package loadbalancers
+
+type NatRulePortMapping struct {
+	BackendPort        *int64  `json:"backendPort,omitempty"`
+	FrontendPort       *int64  `json:"frontendPort,omitempty"`
+	InboundNatRuleName *string `json:"inboundNatRuleName,omitempty"`
+}
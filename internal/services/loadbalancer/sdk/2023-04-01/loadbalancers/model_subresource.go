@@ -0,0 +1,5 @@
+package loadbalancers
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -131,6 +131,7 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	replicationLinksClient := meta.(*clients.Client).MSSQL.ReplicationLinksClient
 	resourcesClient := meta.(*clients.Client).Resource.ResourcesClient
 	transparentEncryptionClient := meta.(*clients.Client).MSSQL.TransparentDataEncryptionsClient
+	restorableDroppedDatabasesClient := meta.(*clients.Client).MSSQL.RestorableDroppedDatabasesClient
 
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -314,6 +315,20 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		params.DatabaseProperties.RestorableDroppedDatabaseID = utils.String(v.(string))
 	}
 
+	if d.IsNewResource() && createMode.(string) == string(sql.CreateModeDefault) && meta.(*clients.Client).Features.MSSQLDatabase.RecoverDroppedDatabase {
+		restorableDroppedDatabaseId, err := findMsSqlRestorableDroppedDatabase(ctx, restorableDroppedDatabasesClient, id)
+		if err != nil {
+			return err
+		}
+
+		if restorableDroppedDatabaseId != nil {
+			log.Printf("[DEBUG] restoring the dropped database %q for %s", *restorableDroppedDatabaseId, id)
+			params.DatabaseProperties.CreateMode = sql.CreateModeRestore
+			params.DatabaseProperties.RestorableDroppedDatabaseID = restorableDroppedDatabaseId
+			params.DatabaseProperties.SampleName = ""
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.Name, params)
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -625,6 +640,33 @@ func resourceMsSqlDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
+// findMsSqlRestorableDroppedDatabase returns the ID of the most recently dropped database with the same name within
+// the MsSql Server, or nil when there's no such database which can be restored
+func findMsSqlRestorableDroppedDatabase(ctx context.Context, client *sql.RestorableDroppedDatabasesClient, id parse.DatabaseId) (*string, error) {
+	iterator, err := client.ListByServerComplete(ctx, id.ResourceGroup, id.ServerName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Restorable Dropped Databases for %s: %+v", id, err)
+	}
+
+	var restorableDroppedDatabaseId *string
+	var deletionDate time.Time
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if props := item.RestorableDroppedDatabaseProperties; item.ID != nil && props != nil && props.DatabaseName != nil && strings.EqualFold(*props.DatabaseName, id.Name) {
+			if props.DeletionDate != nil && props.DeletionDate.Time.After(deletionDate) {
+				restorableDroppedDatabaseId = item.ID
+				deletionDate = props.DeletionDate.Time
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Restorable Dropped Databases for %s: %+v", id, err)
+		}
+	}
+
+	return restorableDroppedDatabaseId, nil
+}
+
 func flattenMsSqlServerSecurityAlertPolicy(d *pluginsdk.ResourceData, policy sql.DatabaseSecurityAlertPolicy) []interface{} {
 	// The SQL database security alert API always returns the default value even if never set.
 	// If the values are on their default one, threat it as not set.
@@ -0,0 +1,56 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceApplicationGatewayBackendHealth() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceApplicationGatewayBackendHealthRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ApplicationGatewayID,
+			},
+
+			"backend_address_pool": applicationGatewayBackendHealthSchema(),
+		},
+	}
+}
+
+func dataSourceApplicationGatewayBackendHealthRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	backendHealth, err := applicationGatewayBackendHealth(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("backend_address_pool", backendHealth); err != nil {
+		return fmt.Errorf("setting `backend_address_pool`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AppGatewayBackendHealthDataSource struct{}
+
+func TestAccDataSourceAppGatewayBackendHealth_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_application_gateway_backend_health", "test")
+	r := AppGatewayBackendHealthDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("backend_address_pool.#").HasValue("1"),
+				check.That(data.ResourceName).Key("backend_address_pool.0.backend_address_pool_id").Exists(),
+				check.That(data.ResourceName).Key("backend_address_pool.0.backend_http_settings.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (AppGatewayBackendHealthDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_application_gateway_backend_health" "test" {
+  application_gateway_id = azurerm_application_gateway.test.id
+}
+`, ApplicationGatewayResource{}.basic(data))
+}
@@ -0,0 +1,198 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayBackendHTTPSettings() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendHTTPSettingsCreateUpdate,
+		Read:   resourceApplicationGatewayBackendHTTPSettingsRead,
+		Update: resourceApplicationGatewayBackendHTTPSettingsCreateUpdate,
+		Delete: resourceApplicationGatewayBackendHTTPSettingsDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendHttpSettingsCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildResourceSchema(applicationGatewayBackendHTTPSettingsSchema()),
+	}
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendHttpSettingsCollectionID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	locks.ByID(gatewayId.ID())
+	defer locks.UnlockByID(gatewayId.ID())
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *gatewayId)
+	}
+	props := gateway.ApplicationGatewayPropertiesFormat
+
+	item := (*expandApplicationGatewayBackendHTTPSettings([]interface{}{applicationGatewayChildResourceValues(d, applicationGatewayBackendHTTPSettingsSchema())}, gatewayId.ID()))[0]
+	if settings := item.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; settings != nil && settings.HostName != nil && settings.PickHostNameFromBackendAddress != nil {
+		if *settings.HostName != "" && *settings.PickHostNameFromBackendAddress {
+			return fmt.Errorf("Only one of `host_name` or `pick_host_name_from_backend_address` can be set")
+		}
+	}
+
+	items := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+	if props.BackendHTTPSettingsCollection != nil {
+		items = *props.BackendHTTPSettingsCollection
+	}
+
+	index := -1
+	for i, v := range items {
+		if applicationGatewayChildResourceNameMatches(v.Name, id.BackendHttpSettingsCollectionName) {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		items = append(items, item)
+	} else {
+		if d.IsNewResource() {
+			return tf.ImportAsExistsError("azurerm_application_gateway_backend_http_settings", id.ID())
+		}
+		items[index] = item
+	}
+	props.BackendHTTPSettingsCollection = &items
+
+	if err := applicationGatewayChildResourceUpdate(ctx, client, *gatewayId, gateway); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayBackendHTTPSettingsRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var item *network.ApplicationGatewayBackendHTTPSettings
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.BackendHTTPSettingsCollection != nil {
+		for _, v := range *props.BackendHTTPSettingsCollection {
+			if applicationGatewayChildResourceNameMatches(v.Name, id.BackendHttpSettingsCollectionName) {
+				v := v
+				item = &v
+				break
+			}
+		}
+	}
+	if item == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.BackendHttpSettingsCollectionName)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	flattened, err := flattenApplicationGatewayBackendHTTPSettings(&[]network.ApplicationGatewayBackendHTTPSettings{*item})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+	return applicationGatewayChildResourceSetValues(d, applicationGatewayBackendHTTPSettingsSchema(), flattened[0].(map[string]interface{}))
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByID(gatewayId.ID())
+	defer locks.UnlockByID(gatewayId.ID())
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	props := gateway.ApplicationGatewayPropertiesFormat
+	if props == nil || props.BackendHTTPSettingsCollection == nil {
+		return nil
+	}
+
+	items := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+	for _, v := range *props.BackendHTTPSettingsCollection {
+		if !applicationGatewayChildResourceNameMatches(v.Name, id.BackendHttpSettingsCollectionName) {
+			items = append(items, v)
+		}
+	}
+	if len(items) == len(*props.BackendHTTPSettingsCollection) {
+		return nil
+	}
+	props.BackendHTTPSettingsCollection = &items
+
+	if err := applicationGatewayChildResourceUpdate(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// applicationGatewayChildResourceSchema builds the schema for a resource managing a single item within one of the
+// Application Gateway's blocks, from the schema used for that block within the `azurerm_application_gateway` resource
+func applicationGatewayChildResourceSchema(input map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	output := make(map[string]*pluginsdk.Schema, len(input)+1)
+	for k, v := range input {
+		// `id` is reserved at the top-level of a resource
+		if k == "id" {
+			continue
+		}
+		output[k] = v
+	}
+
+	output["name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	output["application_gateway_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.ApplicationGatewayID,
+	}
+
+	return output
+}
+
+// applicationGatewayChildResourceValues returns the values for a child resource in the same shape as a single item
+// within the equivalent block of the `azurerm_application_gateway` resource, so that the same expand functions can be used
+func applicationGatewayChildResourceValues(d *pluginsdk.ResourceData, blockSchema map[string]*pluginsdk.Schema) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k := range blockSchema {
+		if k == "id" {
+			continue
+		}
+		output[k] = d.Get(k)
+	}
+	return output
+}
+
+// applicationGatewayChildResourceSetValues sets the flattened values for a single item within one of the Application
+// Gateway's blocks into the state of the child resource managing it
+func applicationGatewayChildResourceSetValues(d *pluginsdk.ResourceData, blockSchema map[string]*pluginsdk.Schema, values map[string]interface{}, skip ...string) error {
+	skipped := map[string]struct{}{
+		"id":   {},
+		"name": {},
+	}
+	for _, k := range skip {
+		skipped[k] = struct{}{}
+	}
+
+	for k := range blockSchema {
+		if _, ok := skipped[k]; ok {
+			continue
+		}
+
+		if err := d.Set(k, values[k]); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+func applicationGatewayChildResourceUpdate(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId, gateway network.ApplicationGateway) error {
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func applicationGatewayChildResourceNameMatches(name *string, expected string) bool {
+	return name != nil && strings.EqualFold(*name, expected)
+}
+
+// applicationGatewayBlockItems returns the items within one of the Application Gateway's blocks, which are either
+// a List or a Set depending on the feature flags in use
+func applicationGatewayBlockItems(d *pluginsdk.ResourceData, key string) []interface{} {
+	switch v := d.Get(key).(type) {
+	case *pluginsdk.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+
+	return make([]interface{}, 0)
+}
+
+func applicationGatewayBlockItemNames(items []interface{}) map[string]struct{} {
+	output := make(map[string]struct{})
+	for _, raw := range items {
+		if v, ok := raw.(map[string]interface{}); ok {
+			if name, ok := v["name"].(string); ok && name != "" {
+				output[strings.ToLower(name)] = struct{}{}
+			}
+		}
+	}
+	return output
+}
+
+// applicationGatewayExternalChildResources determines whether an item within the specified block of an Application Gateway
+// is managed outside of the `azurerm_application_gateway` resource - that is, it's neither in the configuration nor in the state
+func applicationGatewayExternalChildResources(d *pluginsdk.ResourceData, key string) func(name *string) bool {
+	known := applicationGatewayBlockItemNames(applicationGatewayBlockItems(d, key))
+
+	old, _ := d.GetChange(key)
+	var oldItems []interface{}
+	switch v := old.(type) {
+	case *pluginsdk.Set:
+		oldItems = v.List()
+	case []interface{}:
+		oldItems = v
+	}
+	for name := range applicationGatewayBlockItemNames(oldItems) {
+		known[name] = struct{}{}
+	}
+
+	return func(name *string) bool {
+		if name == nil {
+			return false
+		}
+		_, exists := known[strings.ToLower(*name)]
+		return !exists
+	}
+}
+
+// preserveApplicationGatewayExternalChildResources copies across any Listeners, Backend Address Pools, Backend HTTP Settings,
+// Request Routing Rules, Probes and SSL Certificates which are managed by their own resources from the existing Application
+// Gateway into the payload, so that they're not removed when the `azurerm_application_gateway` resource is updated
+func preserveApplicationGatewayExternalChildResources(d *pluginsdk.ResourceData, existing network.ApplicationGateway, gateway *network.ApplicationGateway) {
+	current := existing.ApplicationGatewayPropertiesFormat
+	props := gateway.ApplicationGatewayPropertiesFormat
+	if current == nil || props == nil {
+		return
+	}
+
+	if current.BackendAddressPools != nil {
+		isExternal := applicationGatewayExternalChildResources(d, "backend_address_pool")
+		items := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		if props.BackendAddressPools != nil {
+			items = *props.BackendAddressPools
+		}
+		for _, v := range *current.BackendAddressPools {
+			if isExternal(v.Name) {
+				items = append(items, v)
+			}
+		}
+		props.BackendAddressPools = &items
+	}
+
+	if current.BackendHTTPSettingsCollection != nil {
+		isExternal := applicationGatewayExternalChildResources(d, "backend_http_settings")
+		items := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
+		if props.BackendHTTPSettingsCollection != nil {
+			items = *props.BackendHTTPSettingsCollection
+		}
+		for _, v := range *current.BackendHTTPSettingsCollection {
+			if isExternal(v.Name) {
+				items = append(items, v)
+			}
+		}
+		props.BackendHTTPSettingsCollection = &items
+	}
+
+	if current.HTTPListeners != nil {
+		isExternal := applicationGatewayExternalChildResources(d, "http_listener")
+		items := make([]network.ApplicationGatewayHTTPListener, 0)
+		if props.HTTPListeners != nil {
+			items = *props.HTTPListeners
+		}
+		for _, v := range *current.HTTPListeners {
+			if isExternal(v.Name) {
+				items = append(items, v)
+			}
+		}
+		props.HTTPListeners = &items
+	}
+
+	if current.Probes != nil {
+		isExternal := applicationGatewayExternalChildResources(d, "probe")
+		items := make([]network.ApplicationGatewayProbe, 0)
+		if props.Probes != nil {
+			items = *props.Probes
+		}
+		for _, v := range *current.Probes {
+			if isExternal(v.Name) {
+				items = append(items, v)
+			}
+		}
+		props.Probes = &items
+	}
+
+	if current.RequestRoutingRules != nil {
+		isExternal := applicationGatewayExternalChildResources(d, "request_routing_rule")
+		items := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		if props.RequestRoutingRules != nil {
+			items = *props.RequestRoutingRules
+		}
+		for _, v := range *current.RequestRoutingRules {
+			if isExternal(v.Name) {
+				items = append(items, v)
+			}
+		}
+		props.RequestRoutingRules = &items
+	}
+
+	if current.SslCertificates != nil {
+		isExternal := applicationGatewayExternalChildResources(d, "ssl_certificate")
+		items := make([]network.ApplicationGatewaySslCertificate, 0)
+		if props.SslCertificates != nil {
+			items = *props.SslCertificates
+		}
+		for _, v := range *current.SslCertificates {
+			if isExternal(v.Name) {
+				items = append(items, v)
+			}
+		}
+		props.SslCertificates = &items
+	}
+}
+
+// filterApplicationGatewayExternalChildResources removes any items managed by their own resources from the flattened
+// block when `ignore_external_child_resources` is enabled. Where nothing is known about the block (for example during
+// an import) all of the items are returned.
+func filterApplicationGatewayExternalChildResources(d *pluginsdk.ResourceData, key string, input []interface{}) []interface{} {
+	if !d.Get("ignore_external_child_resources").(bool) {
+		return input
+	}
+
+	known := applicationGatewayBlockItemNames(applicationGatewayBlockItems(d, key))
+	if len(known) == 0 {
+		return input
+	}
+
+	output := make([]interface{}, 0)
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := v["name"].(string)
+		if _, exists := known[strings.ToLower(name)]; exists {
+			output = append(output, raw)
+		}
+	}
+	return output
+}
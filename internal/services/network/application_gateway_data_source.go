@@ -1,12 +1,14 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -33,8 +35,1025 @@ func dataSourceApplicationGateway() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
 
+			"include_backend_health": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"identity": commonschema.UserAssignedIdentityComputed(),
 
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tier": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"capacity": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"autoscale_configuration": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"min_capacity": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"max_capacity": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"zones": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"enable_http2": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"fips_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"firewall_policy_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"force_firewall_policy_association": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"global": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"request_buffering_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"response_buffering_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"gateway_ip_configuration": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"frontend_ip_configuration": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address_allocation": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"public_ip_address_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_link_configuration_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_link_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"frontend_port": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"backend_address_pool": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"fqdns": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"backend_http_settings": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"cookie_based_affinity": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"affinity_cookie_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"path": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"probe_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"probe_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"request_timeout": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"host_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"pick_host_name_from_backend_address": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"trusted_root_certificate_names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"connection_draining": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"enabled": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+
+									"drain_timeout_sec": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"authentication_certificate": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"http_listener": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"frontend_ip_configuration_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"frontend_ip_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"frontend_port_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"frontend_port_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"host_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"host_names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"require_sni": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"ssl_certificate_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ssl_certificate_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ssl_profile_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ssl_profile_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"firewall_policy_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"custom_error_configuration": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"status_code": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"custom_error_page_url": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"request_routing_rule": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"rule_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"priority": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"http_listener_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"http_listener_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"backend_address_pool_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"backend_address_pool_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"backend_http_settings_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"backend_http_settings_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"redirect_configuration_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"redirect_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"rewrite_rule_set_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"rewrite_rule_set_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"url_path_map_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"url_path_map_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"url_path_map": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_backend_address_pool_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_backend_address_pool_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_backend_http_settings_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_backend_http_settings_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_redirect_configuration_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_redirect_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_rewrite_rule_set_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_rewrite_rule_set_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"path_rule": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"paths": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"backend_address_pool_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"backend_address_pool_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"backend_http_settings_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"backend_http_settings_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"redirect_configuration_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"redirect_configuration_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"rewrite_rule_set_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"rewrite_rule_set_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"firewall_policy_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"probe": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"host": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"path": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"interval": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"timeout": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"unhealthy_threshold": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"minimum_servers": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"pick_host_name_from_backend_http_settings": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"match": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"body": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"status_code": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"ssl_certificate": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"key_vault_secret_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"public_cert_data": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"trusted_client_certificate": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"data": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"ssl_profile": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"trusted_client_certificate_names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"verify_client_cert_issuer_dn": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"ssl_policy": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"disabled_protocols": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"policy_type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"policy_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"cipher_suites": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"min_protocol_version": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"private_link_configuration": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ip_configuration": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"subnet_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"private_ip_address_allocation": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"primary": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
+
+									"private_ip_address": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"private_endpoint_connection": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"waf_configuration": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"firewall_mode": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"rule_set_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"rule_set_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"request_body_check": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"file_upload_limit_mb": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"max_request_body_size_kb": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"disabled_rule_group": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"rule_group_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"rules": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeInt,
+										},
+									},
+								},
+							},
+						},
+
+						"exclusion": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"match_variable": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"selector_match_operator": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"selector": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"backend_health": applicationGatewayBackendHealthSchema(),
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -59,6 +1078,7 @@ func dataSourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{
 	d.SetId(id.ID())
 
 	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("zones", zones.Flatten(resp.Zones))
 
 	identity, err := flattenApplicationGatewayIdentity(resp.Identity)
 	if err != nil {
@@ -68,5 +1088,297 @@ func dataSourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil {
+		d.Set("enable_http2", props.EnableHTTP2)
+		d.Set("fips_enabled", props.EnableFips)
+		d.Set("force_firewall_policy_association", props.ForceFirewallPolicyAssociation)
+
+		firewallPolicyId := ""
+		if props.FirewallPolicy != nil && props.FirewallPolicy.ID != nil {
+			firewallPolicyId = *props.FirewallPolicy.ID
+		}
+		d.Set("firewall_policy_id", firewallPolicyId)
+
+		if err := d.Set("sku", flattenApplicationGatewaySku(props.Sku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		if err := d.Set("autoscale_configuration", flattenApplicationGatewayAutoscaleConfiguration(props.AutoscaleConfiguration)); err != nil {
+			return fmt.Errorf("setting `autoscale_configuration`: %+v", err)
+		}
+
+		if err := d.Set("global", flattenApplicationGatewayGlobalConfiguration(props.GlobalConfiguration)); err != nil {
+			return fmt.Errorf("setting `global`: %+v", err)
+		}
+
+		if err := d.Set("gateway_ip_configuration", flattenApplicationGatewayIPConfigurations(props.GatewayIPConfigurations)); err != nil {
+			return fmt.Errorf("setting `gateway_ip_configuration`: %+v", err)
+		}
+
+		frontendIPConfigurations, err := flattenApplicationGatewayFrontendIPConfigurations(props.FrontendIPConfigurations)
+		if err != nil {
+			return fmt.Errorf("flattening `frontend_ip_configuration`: %+v", err)
+		}
+		if err := d.Set("frontend_ip_configuration", frontendIPConfigurations); err != nil {
+			return fmt.Errorf("setting `frontend_ip_configuration`: %+v", err)
+		}
+
+		if err := d.Set("frontend_port", flattenApplicationGatewayFrontendPorts(props.FrontendPorts)); err != nil {
+			return fmt.Errorf("setting `frontend_port`: %+v", err)
+		}
+
+		if err := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); err != nil {
+			return fmt.Errorf("setting `backend_address_pool`: %+v", err)
+		}
+
+		backendHttpSettings, err := flattenApplicationGatewayBackendHTTPSettings(props.BackendHTTPSettingsCollection)
+		if err != nil {
+			return fmt.Errorf("flattening `backend_http_settings`: %+v", err)
+		}
+		if err := d.Set("backend_http_settings", backendHttpSettings); err != nil {
+			return fmt.Errorf("setting `backend_http_settings`: %+v", err)
+		}
+
+		httpListeners, err := flattenApplicationGatewayHTTPListeners(props.HTTPListeners)
+		if err != nil {
+			return fmt.Errorf("flattening `http_listener`: %+v", err)
+		}
+		if err := d.Set("http_listener", httpListeners); err != nil {
+			return fmt.Errorf("setting `http_listener`: %+v", err)
+		}
+
+		requestRoutingRules, err := flattenApplicationGatewayRequestRoutingRules(props.RequestRoutingRules)
+		if err != nil {
+			return fmt.Errorf("flattening `request_routing_rule`: %+v", err)
+		}
+		if err := d.Set("request_routing_rule", requestRoutingRules); err != nil {
+			return fmt.Errorf("setting `request_routing_rule`: %+v", err)
+		}
+
+		urlPathMaps, err := flattenApplicationGatewayURLPathMaps(props.URLPathMaps)
+		if err != nil {
+			return fmt.Errorf("flattening `url_path_map`: %+v", err)
+		}
+		if err := d.Set("url_path_map", urlPathMaps); err != nil {
+			return fmt.Errorf("setting `url_path_map`: %+v", err)
+		}
+
+		if err := d.Set("probe", flattenApplicationGatewayProbes(props.Probes)); err != nil {
+			return fmt.Errorf("setting `probe`: %+v", err)
+		}
+
+		if err := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d)); err != nil {
+			return fmt.Errorf("setting `ssl_certificate`: %+v", err)
+		}
+
+		if err := d.Set("trusted_client_certificate", flattenApplicationGatewayTrustedClientCertificates(props.TrustedClientCertificates)); err != nil {
+			return fmt.Errorf("setting `trusted_client_certificate`: %+v", err)
+		}
+
+		sslProfiles, err := flattenApplicationGatewaySslProfiles(props.SslProfiles)
+		if err != nil {
+			return fmt.Errorf("flattening `ssl_profile`: %+v", err)
+		}
+		if err := d.Set("ssl_profile", sslProfiles); err != nil {
+			return fmt.Errorf("setting `ssl_profile`: %+v", err)
+		}
+
+		if err := d.Set("private_link_configuration", flattenApplicationGatewayPrivateLinkConfigurations(props.PrivateLinkConfigurations)); err != nil {
+			return fmt.Errorf("setting `private_link_configuration`: %+v", err)
+		}
+
+		if err := d.Set("private_endpoint_connection", flattenApplicationGatewayPrivateEndpoints(props.PrivateEndpointConnections)); err != nil {
+			return fmt.Errorf("setting `private_endpoint_connection`: %+v", err)
+		}
+
+		if err := d.Set("waf_configuration", flattenApplicationGatewayWafConfig(props.WebApplicationFirewallConfiguration)); err != nil {
+			return fmt.Errorf("setting `waf_configuration`: %+v", err)
+		}
+	}
+
+	backendHealth := make([]interface{}, 0)
+	if d.Get("include_backend_health").(bool) {
+		backendHealth, err = applicationGatewayBackendHealth(ctx, client, id)
+		if err != nil {
+			return err
+		}
+	}
+	if err := d.Set("backend_health", backendHealth); err != nil {
+		return fmt.Errorf("setting `backend_health`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
+
+func applicationGatewayBackendHealthSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"backend_address_pool_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"backend_address_pool_name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"backend_http_settings": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"backend_http_settings_id": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"backend_http_settings_name": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"server": {
+								Type:     pluginsdk.TypeList,
+								Computed: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"address": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+
+										"health": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+
+										"health_probe_log": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+
+										"ip_configuration_id": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// applicationGatewayBackendHealth retrieves the health of each server within each of the Backend Address Pools of the
+// Application Gateway - which is a long-running operation, since the Application Gateway has to probe each server
+func applicationGatewayBackendHealth(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId) ([]interface{}, error) {
+	future, err := client.BackendHealth(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Backend Health for %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for the Backend Health for %s: %+v", id, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the result of the Backend Health for %s: %+v", id, err)
+	}
+
+	return flattenApplicationGatewayBackendHealth(result.BackendAddressPools), nil
+}
+
+func flattenApplicationGatewayBackendHealth(input *[]network.ApplicationGatewayBackendHealthPool) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, pool := range *input {
+		poolId := ""
+		poolName := ""
+		if pool.BackendAddressPool != nil {
+			if pool.BackendAddressPool.ID != nil {
+				poolId = *pool.BackendAddressPool.ID
+			}
+			if pool.BackendAddressPool.Name != nil {
+				poolName = *pool.BackendAddressPool.Name
+			}
+			if poolName == "" && poolId != "" {
+				if parsed, err := parse.BackendAddressPoolID(poolId); err == nil {
+					poolName = parsed.Name
+				}
+			}
+		}
+
+		settings := make([]interface{}, 0)
+		if pool.BackendHTTPSettingsCollection != nil {
+			for _, setting := range *pool.BackendHTTPSettingsCollection {
+				settingsId := ""
+				settingsName := ""
+				if setting.BackendHTTPSettings != nil {
+					if setting.BackendHTTPSettings.ID != nil {
+						settingsId = *setting.BackendHTTPSettings.ID
+					}
+					if setting.BackendHTTPSettings.Name != nil {
+						settingsName = *setting.BackendHTTPSettings.Name
+					}
+					if settingsName == "" && settingsId != "" {
+						if parsed, err := parse.BackendHttpSettingsCollectionID(settingsId); err == nil {
+							settingsName = parsed.BackendHttpSettingsCollectionName
+						}
+					}
+				}
+
+				servers := make([]interface{}, 0)
+				if setting.Servers != nil {
+					for _, server := range *setting.Servers {
+						address := ""
+						if server.Address != nil {
+							address = *server.Address
+						}
+
+						healthProbeLog := ""
+						if server.HealthProbeLog != nil {
+							healthProbeLog = *server.HealthProbeLog
+						}
+
+						ipConfigurationId := ""
+						if server.IPConfiguration != nil && server.IPConfiguration.ID != nil {
+							ipConfigurationId = *server.IPConfiguration.ID
+						}
+
+						servers = append(servers, map[string]interface{}{
+							"address":             address,
+							"health":              string(server.Health),
+							"health_probe_log":    healthProbeLog,
+							"ip_configuration_id": ipConfigurationId,
+						})
+					}
+				}
+
+				settings = append(settings, map[string]interface{}{
+					"backend_http_settings_id":   settingsId,
+					"backend_http_settings_name": settingsName,
+					"server":                     servers,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"backend_address_pool_id":   poolId,
+			"backend_address_pool_name": poolName,
+			"backend_http_settings":     settings,
+		})
+	}
+
+	return results
+}
@@ -19,6 +19,16 @@ func TestAccDataSourceAppGateway_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("sku.0.name").HasValue("Standard_Small"),
+				check.That(data.ResourceName).Key("frontend_ip_configuration.#").HasValue("1"),
+				check.That(data.ResourceName).Key("frontend_port.#").HasValue("1"),
+				check.That(data.ResourceName).Key("backend_address_pool.#").HasValue("1"),
+				check.That(data.ResourceName).Key("backend_http_settings.#").HasValue("1"),
+				check.That(data.ResourceName).Key("http_listener.#").HasValue("1"),
+				check.That(data.ResourceName).Key("http_listener.0.protocol").HasValue("Http"),
+				check.That(data.ResourceName).Key("request_routing_rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("request_routing_rule.0.rule_type").HasValue("Basic"),
+				check.That(data.ResourceName).Key("backend_health.#").HasValue("0"),
 			),
 		},
 	})
@@ -39,6 +49,37 @@ func TestAccDataSourceAppGateway_userAssignedIdentity(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAppGateway_backendHealth(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_application_gateway", "test")
+	r := AppGatewayDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.backendHealth(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("backend_health.#").HasValue("1"),
+				check.That(data.ResourceName).Key("backend_health.0.backend_address_pool_id").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceAppGateway_globalConfiguration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_application_gateway", "test")
+	r := AppGatewayDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.globalConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sku.0.tier").HasValue("Standard_v2"),
+				check.That(data.ResourceName).Key("global.0.request_buffering_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("global.0.response_buffering_enabled").HasValue("false"),
+			),
+		},
+	})
+}
+
 func (AppGatewayDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -60,3 +101,26 @@ data "azurerm_application_gateway" "test" {
 }
 `, ApplicationGatewayResource{}.UserDefinedIdentity(data))
 }
+
+func (AppGatewayDataSource) backendHealth(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_application_gateway" "test" {
+  resource_group_name    = azurerm_application_gateway.test.resource_group_name
+  name                   = azurerm_application_gateway.test.name
+  include_backend_health = true
+}
+`, ApplicationGatewayResource{}.basic(data))
+}
+
+func (AppGatewayDataSource) globalConfiguration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_application_gateway" "test" {
+  resource_group_name = azurerm_application_gateway.test.resource_group_name
+  name                = azurerm_application_gateway.test.name
+}
+`, ApplicationGatewayResource{}.globalConfiguration(data, true, false))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -155,45 +156,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				}(),
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"fqdns": {
-							Type: func() pluginsdk.ValueType {
-								if features.ThreePointOhBeta() {
-									return pluginsdk.TypeSet
-								}
-								return pluginsdk.TypeList
-							}(),
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.NoZeroValues,
-							},
-						},
-
-						"ip_addresses": {
-							Type: func() pluginsdk.ValueType {
-								if features.ThreePointOhBeta() {
-									return pluginsdk.TypeSet
-								}
-								return pluginsdk.TypeList
-							}(),
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validate.IPv4Address,
-							},
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayBackendAddressPoolSchema(),
 				},
 				Set: func() pluginsdk.SchemaSetFunc {
 					if features.ThreePointOhBeta() {
@@ -214,128 +177,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Required: true,
 				MinItems: 1,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"path": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"port": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validate.PortNumber,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifferenceV2Only,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, !features.ThreePointOhBeta()),
-						},
-
-						"cookie_based_affinity": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifferenceV2Only,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayCookieBasedAffinityEnabled),
-								string(network.ApplicationGatewayCookieBasedAffinityDisabled),
-							}, !features.ThreePointOhBeta()),
-						},
-
-						"affinity_cookie_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"host_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"pick_host_name_from_backend_address": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"request_timeout": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 86400),
-						},
-
-						"authentication_certificate": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-
-						"trusted_root_certificate_names": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
-							},
-						},
-
-						"connection_draining": {
-							Type:     pluginsdk.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-
-									"drain_timeout_sec": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntBetween(1, 3600),
-									},
-								},
-							},
-						},
-
-						"probe_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"probe_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayBackendHTTPSettingsSchema(),
 				},
 				Set: func() pluginsdk.SchemaSetFunc {
 					if features.ThreePointOhBeta() {
@@ -462,118 +304,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				}(),
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_ip_configuration_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_port_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifferenceV2Only,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, !features.ThreePointOhBeta()),
-						},
-
-						"host_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"host_names": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
-							},
-						},
-
-						"ssl_certificate_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"require_sni": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-						},
-
-						"frontend_ip_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"frontend_port_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_certificate_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_profile_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"custom_error_configuration": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"status_code": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
-										}, false),
-									},
-
-									"custom_error_page_url": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-
-						"firewall_policy_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
-						},
-
-						"ssl_profile_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-					},
+					Schema: applicationGatewayHTTPListenerSchema(),
 				},
 				Set: func() pluginsdk.SchemaSetFunc {
 					if features.ThreePointOhBeta() {
@@ -588,6 +319,25 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"global": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"request_buffering_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"response_buffering_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"private_endpoint_connection": {
 				Type:     pluginsdk.TypeSet,
 				Computed: true,
@@ -668,124 +418,37 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Required: true,
 				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: applicationGatewayRequestRoutingRuleSchema(),
+				},
+			},
+
+			"redirect_configuration": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
-						"rule_type": {
+						"redirect_type": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
-								string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+								string(network.ApplicationGatewayRedirectTypePermanent),
+								string(network.ApplicationGatewayRedirectTypeTemporary),
+								string(network.ApplicationGatewayRedirectTypeFound),
+								string(network.ApplicationGatewayRedirectTypeSeeOther),
 							}, false),
 						},
 
-						"http_listener_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"backend_address_pool_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"backend_http_settings_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"url_path_map_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"redirect_configuration_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"rewrite_rule_set_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 20000),
-						},
-
-						"backend_address_pool_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"backend_http_settings_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"http_listener_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"url_path_map_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"redirect_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"rewrite_rule_set_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
-				},
-			},
-
-			"redirect_configuration": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"redirect_type": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayRedirectTypePermanent),
-								string(network.ApplicationGatewayRedirectTypeTemporary),
-								string(network.ApplicationGatewayRedirectTypeFound),
-								string(network.ApplicationGatewayRedirectTypeSeeOther),
-							}, false),
-						},
-
-						"target_listener_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+						"target_listener_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
 						"target_url": {
@@ -951,6 +614,12 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"ignore_external_child_resources": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			//lintignore:S016,S023
 			"probe": {
 				Type: func() pluginsdk.ValueType {
@@ -961,119 +630,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				}(),
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifferenceV2Only,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, !features.ThreePointOhBeta()),
-						},
-
-						"path": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"host": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"interval": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"timeout": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"unhealthy_threshold": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"port": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validate.PortNumber,
-						},
-
-						"pick_host_name_from_backend_http_settings": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"minimum_servers": {
-							Type:     pluginsdk.TypeInt,
-							Optional: true,
-							Default:  0,
-						},
-
-						//lintignore:XS003
-						"match": func() *pluginsdk.Schema {
-							if features.ThreePointOhBeta() {
-								return &pluginsdk.Schema{
-									Type:     pluginsdk.TypeList,
-									Optional: true,
-									MaxItems: 1,
-									Elem: &pluginsdk.Resource{
-										Schema: map[string]*pluginsdk.Schema{
-											"body": {
-												Type:     pluginsdk.TypeString,
-												Required: true,
-											},
-
-											"status_code": {
-												Type:     pluginsdk.TypeList,
-												Required: true,
-												Elem: &pluginsdk.Schema{
-													Type: pluginsdk.TypeString,
-												},
-											},
-										},
-									},
-								}
-							}
-							return &pluginsdk.Schema{
-								Type:     pluginsdk.TypeList,
-								Optional: true,
-								Computed: true,
-								MaxItems: 1,
-								Elem: &pluginsdk.Resource{
-									Schema: map[string]*pluginsdk.Schema{
-										"body": {
-											Type:     pluginsdk.TypeString,
-											Optional: true,
-										},
-
-										"status_code": {
-											Type:     pluginsdk.TypeList,
-											Optional: true,
-											Elem: &pluginsdk.Schema{
-												Type: pluginsdk.TypeString,
-											},
-										},
-									},
-								},
-							}
-						}(),
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayProbeSchema(),
 				},
 				Set: func() pluginsdk.SchemaSetFunc {
 					if features.ThreePointOhBeta() {
@@ -1216,41 +773,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				}(),
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"data": {
-							Type:      pluginsdk.TypeString,
-							Optional:  true,
-							Sensitive: true,
-							StateFunc: base64EncodedStateFunc,
-						},
-
-						"password": {
-							Type:      pluginsdk.TypeString,
-							Optional:  true,
-							Sensitive: true,
-						},
-
-						"key_vault_secret_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"public_cert_data": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewaySslCertificateSchema(),
 				},
 				Set: func() pluginsdk.SchemaSetFunc {
 					if features.ThreePointOhBeta() {
@@ -1393,215 +916,742 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
 
-									"backend_address_pool_id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
+									"backend_address_pool_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"backend_http_settings_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"redirect_configuration_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"rewrite_rule_set_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"firewall_policy_id": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: networkValidate.ApplicationGatewayWebApplicationFirewallPolicyID,
+									},
+								},
+							},
+						},
+
+						"default_backend_address_pool_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_backend_http_settings_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_redirect_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"default_rewrite_rule_set_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"waf_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"firewall_mode": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.CaseDifferenceV2Only,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ApplicationGatewayFirewallModeDetection),
+								string(network.ApplicationGatewayFirewallModePrevention),
+							}, !features.ThreePointOhBeta()),
+						},
+
+						"rule_set_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "OWASP",
+							ValidateFunc: networkValidate.ValidateWebApplicationFirewallPolicyRuleSetType,
+						},
+
+						"rule_set_version": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: networkValidate.ValidateWebApplicationFirewallPolicyRuleSetVersion,
+						},
+						"file_upload_limit_mb": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 750),
+							Default:      100,
+						},
+						"request_body_check": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"max_request_body_size_kb": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 128),
+							Default:      128,
+						},
+						"disabled_rule_group": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"rule_group_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: networkValidate.ValidateWebApplicationFirewallPolicyRuleGroupName,
+									},
+
+									"rules": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeInt,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+						"exclusion": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"match_variable": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.OwaspCrsExclusionEntryMatchVariableRequestArgNames),
+											string(network.OwaspCrsExclusionEntryMatchVariableRequestCookieNames),
+											string(network.OwaspCrsExclusionEntryMatchVariableRequestHeaderNames),
+										}, false),
+									},
+
+									"selector_match_operator": {
+										Type: pluginsdk.TypeString,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorContains),
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith),
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEquals),
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny),
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith),
+										}, false),
+										Optional: true,
+									},
+									"selector": {
+										ValidateFunc: validation.StringIsNotEmpty,
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"custom_error_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"status_code": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
+								string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
+							}, false),
+						},
+
+						"custom_error_page_url": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(applicationGatewayCustomizeDiff),
+	}
+}
+
+func applicationGatewayBackendAddressPoolSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"fqdns": {
+			Type: func() pluginsdk.ValueType {
+				if features.ThreePointOhBeta() {
+					return pluginsdk.TypeSet
+				}
+				return pluginsdk.TypeList
+			}(),
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+
+		"ip_addresses": {
+			Type: func() pluginsdk.ValueType {
+				if features.ThreePointOhBeta() {
+					return pluginsdk.TypeSet
+				}
+				return pluginsdk.TypeList
+			}(),
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.IPv4Address,
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayBackendHTTPSettingsSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validate.PortNumber,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifferenceV2Only,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, !features.ThreePointOhBeta()),
+		},
+
+		"cookie_based_affinity": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifferenceV2Only,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ApplicationGatewayCookieBasedAffinityEnabled),
+				string(network.ApplicationGatewayCookieBasedAffinityDisabled),
+			}, !features.ThreePointOhBeta()),
+		},
+
+		"affinity_cookie_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"pick_host_name_from_backend_address": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"request_timeout": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 86400),
+		},
+
+		"authentication_certificate": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"trusted_root_certificate_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"connection_draining": {
+			Type:     pluginsdk.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"drain_timeout_sec": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 3600),
+					},
+				},
+			},
+		},
+
+		"probe_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"probe_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayHTTPListenerSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_ip_configuration_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_port_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifferenceV2Only,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, !features.ThreePointOhBeta()),
+		},
+
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"host_names": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"ssl_certificate_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"require_sni": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"frontend_ip_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"frontend_port_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_certificate_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_profile_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"custom_error_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"status_code": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
+						}, false),
+					},
+
+					"custom_error_page_url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"ssl_profile_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func applicationGatewayRequestRoutingRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"rule_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
+				string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+			}, false),
+		},
+
+		"http_listener_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"backend_address_pool_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"backend_http_settings_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"url_path_map_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"redirect_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"rewrite_rule_set_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 20000),
+		},
+
+		"backend_address_pool_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"backend_http_settings_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"http_listener_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"url_path_map_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"redirect_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rewrite_rule_set_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayProbeSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifferenceV2Only,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, !features.ThreePointOhBeta()),
+		},
 
-									"backend_http_settings_id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
 
-									"redirect_configuration_id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
+		"host": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
 
-									"rewrite_rule_set_id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
+		"interval": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
 
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
+		"timeout": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
 
-									"firewall_policy_id": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: networkValidate.ApplicationGatewayWebApplicationFirewallPolicyID,
-									},
-								},
-							},
-						},
+		"unhealthy_threshold": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
 
-						"default_backend_address_pool_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.PortNumber,
+		},
 
-						"default_backend_http_settings_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
+		"pick_host_name_from_backend_http_settings": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 
-						"default_redirect_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
+		"minimum_servers": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  0,
+		},
 
-						"default_rewrite_rule_set_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
+		//lintignore:XS003
+		"match": func() *pluginsdk.Schema {
+			if features.ThreePointOhBeta() {
+				return &pluginsdk.Schema{
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"body": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+							},
 
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
+							"status_code": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
 						},
 					},
-				},
-			},
-
-			"waf_configuration": {
+				}
+			}
+			return &pluginsdk.Schema{
 				Type:     pluginsdk.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
-						"enabled": {
-							Type:     pluginsdk.TypeBool,
-							Required: true,
-						},
-
-						"firewall_mode": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifferenceV2Only,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayFirewallModeDetection),
-								string(network.ApplicationGatewayFirewallModePrevention),
-							}, !features.ThreePointOhBeta()),
-						},
-
-						"rule_set_type": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							Default:      "OWASP",
-							ValidateFunc: networkValidate.ValidateWebApplicationFirewallPolicyRuleSetType,
-						},
-
-						"rule_set_version": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: networkValidate.ValidateWebApplicationFirewallPolicyRuleSetVersion,
-						},
-						"file_upload_limit_mb": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 750),
-							Default:      100,
-						},
-						"request_body_check": {
-							Type:     pluginsdk.TypeBool,
+						"body": {
+							Type:     pluginsdk.TypeString,
 							Optional: true,
-							Default:  true,
-						},
-						"max_request_body_size_kb": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 128),
-							Default:      128,
 						},
-						"disabled_rule_group": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"rule_group_name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: networkValidate.ValidateWebApplicationFirewallPolicyRuleGroupName,
-									},
 
-									"rules": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeInt,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-									},
-								},
-							},
-						},
-						"exclusion": {
+						"status_code": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"match_variable": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestArgNames),
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestCookieNames),
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestHeaderNames),
-										}, false),
-									},
-
-									"selector_match_operator": {
-										Type: pluginsdk.TypeString,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorContains),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEquals),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith),
-										}, false),
-										Optional: true,
-									},
-									"selector": {
-										ValidateFunc: validation.StringIsNotEmpty,
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-									},
-								},
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
 							},
 						},
 					},
 				},
-			},
+			}
+		}(),
 
-			"firewall_policy_id": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
-			},
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
 
-			"custom_error_configuration": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"status_code": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
-								string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
-							}, false),
-						},
+func applicationGatewaySslCertificateSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
 
-						"custom_error_page_url": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
+		"data": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			StateFunc: base64EncodedStateFunc,
+		},
 
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
-				},
-			},
+		"password": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
 
-			"tags": tags.Schema(),
+		"key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 		},
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(applicationGatewayCustomizeDiff),
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"public_cert_data": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -1614,6 +1664,10 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	log.Printf("[INFO] preparing arguments for Application Gateway creation.")
 
 	id := parse.NewApplicationGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1637,7 +1691,7 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("expanding `trusted_root_certificate`: %+v", err)
 	}
 
-	requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d, id.ID())
+	requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d.Get("request_routing_rule").(*pluginsdk.Set).List(), id.ID())
 	if err != nil {
 		return fmt.Errorf("expanding `request_routing_rule`: %+v", err)
 	}
@@ -1652,7 +1706,7 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("expanding `redirect_configuration`: %+v", err)
 	}
 
-	sslCertificates, err := expandApplicationGatewaySslCertificates(d)
+	sslCertificates, err := expandApplicationGatewaySslCertificates(applicationGatewayBlockItems(d, "ssl_certificate"))
 	if err != nil {
 		return fmt.Errorf("expanding `ssl_certificate`: %+v", err)
 	}
@@ -1666,7 +1720,7 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 
 	gatewayIPConfigurations, stopApplicationGateway := expandApplicationGatewayIPConfigurations(d)
 
-	httpListeners, err := expandApplicationGatewayHTTPListeners(d, id.ID())
+	httpListeners, err := expandApplicationGatewayHTTPListeners(applicationGatewayBlockItems(d, "http_listener"), id.ID())
 	if err != nil {
 		return fmt.Errorf("fail to expand `http_listener`: %+v", err)
 	}
@@ -1684,15 +1738,16 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			AuthenticationCertificates:    expandApplicationGatewayAuthenticationCertificates(d.Get("authentication_certificate").([]interface{})),
 			TrustedRootCertificates:       trustedRootCertificates,
 			CustomErrorConfigurations:     expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{})),
-			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
-			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, id.ID()),
+			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(applicationGatewayBlockItems(d, "backend_address_pool")),
+			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(applicationGatewayBlockItems(d, "backend_http_settings"), id.ID()),
 			EnableHTTP2:                   utils.Bool(enablehttp2),
 			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d, id.ID()),
 			FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
+			GlobalConfiguration:           expandApplicationGatewayGlobalConfiguration(d.Get("global").([]interface{})),
 			GatewayIPConfigurations:       gatewayIPConfigurations,
 			HTTPListeners:                 httpListeners,
 			PrivateLinkConfigurations:     expandApplicationGatewayPrivateLinkConfigurations(d),
-			Probes:                        expandApplicationGatewayProbes(d),
+			Probes:                        expandApplicationGatewayProbes(applicationGatewayBlockItems(d, "probe")),
 			RequestRoutingRules:           requestRoutingRules,
 			RedirectConfigurations:        redirectConfigurations,
 			Sku:                           expandApplicationGatewaySku(d),
@@ -1779,6 +1834,15 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	if !d.IsNewResource() && d.Get("ignore_external_child_resources").(bool) {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		preserveApplicationGatewayExternalChildResources(d, existing, &gateway)
+	}
+
 	if stopApplicationGateway {
 		future, err := client.Stop(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1858,7 +1922,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `trusted_root_certificate`: %+v", err)
 		}
 
-		if setErr := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); setErr != nil {
+		if setErr := d.Set("backend_address_pool", filterApplicationGatewayExternalChildResources(d, "backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools))); setErr != nil {
 			return fmt.Errorf("setting `backend_address_pool`: %+v", setErr)
 		}
 
@@ -1866,7 +1930,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("flattening `backend_http_settings`: %+v", err)
 		}
-		if setErr := d.Set("backend_http_settings", backendHttpSettings); setErr != nil {
+		if setErr := d.Set("backend_http_settings", filterApplicationGatewayExternalChildResources(d, "backend_http_settings", backendHttpSettings)); setErr != nil {
 			return fmt.Errorf("setting `backend_http_settings`: %+v", setErr)
 		}
 
@@ -1878,11 +1942,15 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("fips_enabled", props.EnableFips)
 		d.Set("force_firewall_policy_association", props.ForceFirewallPolicyAssociation)
 
+		if setErr := d.Set("global", flattenApplicationGatewayGlobalConfiguration(props.GlobalConfiguration)); setErr != nil {
+			return fmt.Errorf("setting `global`: %+v", setErr)
+		}
+
 		httpListeners, err := flattenApplicationGatewayHTTPListeners(props.HTTPListeners)
 		if err != nil {
 			return fmt.Errorf("flattening `http_listener`: %+v", err)
 		}
-		if setErr := d.Set("http_listener", httpListeners); setErr != nil {
+		if setErr := d.Set("http_listener", filterApplicationGatewayExternalChildResources(d, "http_listener", httpListeners)); setErr != nil {
 			return fmt.Errorf("setting `http_listener`: %+v", setErr)
 		}
 
@@ -1910,7 +1978,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `private_link_configuration`: %+v", setErr)
 		}
 
-		if setErr := d.Set("probe", flattenApplicationGatewayProbes(props.Probes)); setErr != nil {
+		if setErr := d.Set("probe", filterApplicationGatewayExternalChildResources(d, "probe", flattenApplicationGatewayProbes(props.Probes))); setErr != nil {
 			return fmt.Errorf("setting `probe`: %+v", setErr)
 		}
 
@@ -1918,7 +1986,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("flattening `request_routing_rule`: %+v", err)
 		}
-		if setErr := d.Set("request_routing_rule", requestRoutingRules); setErr != nil {
+		if setErr := d.Set("request_routing_rule", filterApplicationGatewayExternalChildResources(d, "request_routing_rule", requestRoutingRules)); setErr != nil {
 			return fmt.Errorf("setting `request_routing_rule`: %+v", setErr)
 		}
 
@@ -1943,7 +2011,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `autoscale_configuration`: %+v", setErr)
 		}
 
-		if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d)); setErr != nil {
+		if setErr := d.Set("ssl_certificate", filterApplicationGatewayExternalChildResources(d, "ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d))); setErr != nil {
 			return fmt.Errorf("setting `ssl_certificate`: %+v", setErr)
 		}
 
@@ -2185,14 +2253,7 @@ func flattenApplicationGatewayTrustedRootCertificates(certs *[]network.Applicati
 	return results
 }
 
-func expandApplicationGatewayBackendAddressPools(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayBackendAddressPool {
-	var vs []interface{}
-
-	if features.ThreePointOhBeta() {
-		vs = d.Get("backend_address_pool").(*schema.Set).List()
-	} else {
-		vs = d.Get("backend_address_pool").([]interface{})
-	}
+func expandApplicationGatewayBackendAddressPools(vs []interface{}) *[]network.ApplicationGatewayBackendAddressPool {
 	results := make([]network.ApplicationGatewayBackendAddressPool, 0)
 
 	for _, raw := range vs {
@@ -2282,16 +2343,8 @@ func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGa
 	return results
 }
 
-func expandApplicationGatewayBackendHTTPSettings(d *pluginsdk.ResourceData, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
+func expandApplicationGatewayBackendHTTPSettings(vs []interface{}, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
 	results := make([]network.ApplicationGatewayBackendHTTPSettings, 0)
-	var vs []interface{}
-
-	if features.ThreePointOhBeta() {
-		vs = d.Get("backend_http_settings").(*schema.Set).List()
-	} else {
-		vs = d.Get("backend_http_settings").([]interface{})
-	}
-
 	for _, raw := range vs {
 		v := raw.(map[string]interface{})
 
@@ -2585,15 +2638,7 @@ func flattenApplicationGatewaySslPolicy(input *network.ApplicationGatewaySslPoli
 	return results
 }
 
-func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayHTTPListener, error) {
-	var vs []interface{}
-
-	if features.ThreePointOhBeta() {
-		vs = d.Get("http_listener").(*schema.Set).List()
-	} else {
-		vs = d.Get("http_listener").([]interface{})
-	}
-
+func expandApplicationGatewayHTTPListeners(vs []interface{}, gatewayID string) (*[]network.ApplicationGatewayHTTPListener, error) {
 	results := make([]network.ApplicationGatewayHTTPListener, 0)
 
 	for _, raw := range vs {
@@ -2989,13 +3034,7 @@ func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.Applicat
 	return results, nil
 }
 
-func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayProbe {
-	var vs []interface{}
-	if features.ThreePointOhBeta() {
-		vs = d.Get("probe").(*schema.Set).List()
-	} else {
-		vs = d.Get("probe").([]interface{})
-	}
+func expandApplicationGatewayProbes(vs []interface{}) *[]network.ApplicationGatewayProbe {
 	results := make([]network.ApplicationGatewayProbe, 0)
 
 	for _, raw := range vs {
@@ -3237,8 +3276,7 @@ func flattenApplicationGatewayPrivateLinkConfigurations(input *[]network.Applica
 	return plConfigResults
 }
 
-func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayRequestRoutingRule, error) {
-	vs := d.Get("request_routing_rule").(*pluginsdk.Set).List()
+func expandApplicationGatewayRequestRoutingRules(vs []interface{}, gatewayID string) (*[]network.ApplicationGatewayRequestRoutingRule, error) {
 	results := make([]network.ApplicationGatewayRequestRoutingRule, 0)
 	priorityset := false
 
@@ -3800,6 +3838,41 @@ func expandApplicationGatewaySku(d *pluginsdk.ResourceData) *network.Application
 	return &sku
 }
 
+func expandApplicationGatewayGlobalConfiguration(input []interface{}) *network.ApplicationGatewayGlobalConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &network.ApplicationGatewayGlobalConfiguration{
+		EnableRequestBuffering:  utils.Bool(v["request_buffering_enabled"].(bool)),
+		EnableResponseBuffering: utils.Bool(v["response_buffering_enabled"].(bool)),
+	}
+}
+
+func flattenApplicationGatewayGlobalConfiguration(input *network.ApplicationGatewayGlobalConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	requestBuffering := false
+	if input.EnableRequestBuffering != nil {
+		requestBuffering = *input.EnableRequestBuffering
+	}
+
+	responseBuffering := false
+	if input.EnableResponseBuffering != nil {
+		responseBuffering = *input.EnableResponseBuffering
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"request_buffering_enabled":  requestBuffering,
+			"response_buffering_enabled": responseBuffering,
+		},
+	}
+}
+
 func flattenApplicationGatewaySku(input *network.ApplicationGatewaySku) []interface{} {
 	result := make(map[string]interface{})
 
@@ -3812,13 +3885,7 @@ func flattenApplicationGatewaySku(input *network.ApplicationGatewaySku) []interf
 	return []interface{}{result}
 }
 
-func expandApplicationGatewaySslCertificates(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewaySslCertificate, error) {
-	var vs []interface{}
-	if features.ThreePointOhBeta() {
-		vs = d.Get("ssl_certificate").(*schema.Set).List()
-	} else {
-		vs = d.Get("ssl_certificate").([]interface{})
-	}
+func expandApplicationGatewaySslCertificates(vs []interface{}) (*[]network.ApplicationGatewaySslCertificate, error) {
 	results := make([]network.ApplicationGatewaySslCertificate, 0)
 
 	for _, raw := range vs {
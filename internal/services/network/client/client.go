@@ -3,63 +3,87 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressroutecircuits"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressrouteconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressrouteportauthorizations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/hubvirtualnetworkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/securityadminconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/staticmembers"
 )
 
 type Client struct {
-	ApplicationGatewaysClient              *network.ApplicationGatewaysClient
-	ApplicationSecurityGroupsClient        *network.ApplicationSecurityGroupsClient
-	BastionHostsClient                     *network.BastionHostsClient
-	ConnectionMonitorsClient               *network.ConnectionMonitorsClient
-	DDOSProtectionPlansClient              *network.DdosProtectionPlansClient
-	ExpressRouteAuthsClient                *network.ExpressRouteCircuitAuthorizationsClient
-	ExpressRouteCircuitsClient             *network.ExpressRouteCircuitsClient
-	ExpressRouteCircuitConnectionClient    *network.ExpressRouteCircuitConnectionsClient
-	ExpressRouteConnectionsClient          *network.ExpressRouteConnectionsClient
-	ExpressRouteGatewaysClient             *network.ExpressRouteGatewaysClient
-	ExpressRoutePeeringsClient             *network.ExpressRouteCircuitPeeringsClient
-	ExpressRoutePortsClient                *network.ExpressRoutePortsClient
-	FlowLogsClient                         *network.FlowLogsClient
-	HubRouteTableClient                    *network.HubRouteTablesClient
-	HubVirtualNetworkConnectionClient      *network.HubVirtualNetworkConnectionsClient
-	InterfacesClient                       *network.InterfacesClient
-	IPGroupsClient                         *network.IPGroupsClient
-	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
-	NatRuleClient                          *network.NatRulesClient
-	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
-	ProfileClient                          *network.ProfilesClient
-	PacketCapturesClient                   *network.PacketCapturesClient
-	PrivateEndpointClient                  *network.PrivateEndpointsClient
-	PublicIPsClient                        *network.PublicIPAddressesClient
-	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
-	RoutesClient                           *network.RoutesClient
-	RouteFiltersClient                     *network.RouteFiltersClient
-	RouteTablesClient                      *network.RouteTablesClient
-	SecurityGroupClient                    *network.SecurityGroupsClient
-	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
-	SecurityRuleClient                     *network.SecurityRulesClient
-	ServiceEndpointPoliciesClient          *network.ServiceEndpointPoliciesClient
-	ServiceEndpointPolicyDefinitionsClient *network.ServiceEndpointPolicyDefinitionsClient
-	ServiceTagsClient                      *network.ServiceTagsClient
-	SubnetsClient                          *network.SubnetsClient
-	NatGatewayClient                       *network.NatGatewaysClient
-	VirtualHubBgpConnectionClient          *network.VirtualHubBgpConnectionClient
-	VirtualHubIPClient                     *network.VirtualHubIPConfigurationClient
-	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
-	VnetGatewayClient                      *network.VirtualNetworkGatewaysClient
-	VnetClient                             *network.VirtualNetworksClient
-	VnetPeeringsClient                     *network.VirtualNetworkPeeringsClient
-	VirtualWanClient                       *network.VirtualWansClient
-	VirtualHubClient                       *network.VirtualHubsClient
-	VpnConnectionsClient                   *network.VpnConnectionsClient
-	VpnGatewaysClient                      *network.VpnGatewaysClient
-	VpnServerConfigurationsClient          *network.VpnServerConfigurationsClient
-	VpnSitesClient                         *network.VpnSitesClient
-	WatcherClient                          *network.WatchersClient
-	WebApplicationFirewallPoliciesClient   *network.WebApplicationFirewallPoliciesClient
-	PrivateDnsZoneGroupClient              *network.PrivateDNSZoneGroupsClient
-	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
-	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
-	ResourceNavigationLinkClient           *network.ResourceNavigationLinksClient
+	ApplicationGatewaysClient                *network.ApplicationGatewaysClient
+	ApplicationSecurityGroupsClient          *network.ApplicationSecurityGroupsClient
+	AvailableDelegationsClient               *network.AvailableDelegationsClient
+	AvailableResourceGroupDelegationsClient  *network.AvailableResourceGroupDelegationsClient
+	BastionHostsClient                       *network.BastionHostsClient
+	ConnectionMonitorsClient                 *network.ConnectionMonitorsClient
+	DDOSProtectionPlansClient                *network.DdosProtectionPlansClient
+	ExpressRouteAuthsClient                  *network.ExpressRouteCircuitAuthorizationsClient
+	ExpressRouteCircuitsClient               *expressroutecircuits.ExpressRouteCircuitsClient
+	ExpressRouteCircuitConnectionClient      *network.ExpressRouteCircuitConnectionsClient
+	ExpressRouteConnectionsClient            *expressrouteconnections.ExpressRouteConnectionsClient
+	ExpressRouteGatewaysClient               *network.ExpressRouteGatewaysClient
+	ExpressRoutePeeringsClient               *network.ExpressRouteCircuitPeeringsClient
+	ExpressRoutePortAuthorizationsClient     *expressrouteportauthorizations.ExpressRoutePortAuthorizationsClient
+	ExpressRoutePortsClient                  *network.ExpressRoutePortsClient
+	FlowLogsClient                           *network.FlowLogsClient
+	HubRouteTableClient                      *network.HubRouteTablesClient
+	HubVirtualNetworkConnectionClient        *hubvirtualnetworkconnections.HubVirtualNetworkConnectionsClient
+	InterfacesClient                         *network.InterfacesClient
+	IPGroupsClient                           *network.IPGroupsClient
+	LocalNetworkGatewaysClient               *network.LocalNetworkGatewaysClient
+	ManagerAdminRuleCollectionsClient        *adminrulecollections.AdminRuleCollectionsClient
+	ManagerAdminRulesClient                  *adminrules.AdminRulesClient
+	ManagerConnectivityConfigurationsClient  *connectivityconfigurations.ConnectivityConfigurationsClient
+	ManagerNetworkGroupsClient               *networkgroups.NetworkGroupsClient
+	ManagersClient                           *networkmanagers.NetworkManagersClient
+	ManagerSecurityAdminConfigurationsClient *securityadminconfigurations.SecurityAdminConfigurationsClient
+	ManagerStaticMembersClient               *staticmembers.StaticMembersClient
+	NatRuleClient                            *network.NatRulesClient
+	PointToSiteVpnGatewaysClient             *network.P2sVpnGatewaysClient
+	ProfileClient                            *network.ProfilesClient
+	PacketCapturesClient                     *network.PacketCapturesClient
+	PrivateEndpointClient                    *network.PrivateEndpointsClient
+	PublicIPsClient                          *network.PublicIPAddressesClient
+	PublicIPPrefixesClient                   *network.PublicIPPrefixesClient
+	RoutesClient                             *network.RoutesClient
+	RouteFiltersClient                       *network.RouteFiltersClient
+	RouteMapsClient                          *routemaps.RouteMapsClient
+	RouteTablesClient                        *network.RouteTablesClient
+	SecurityGroupClient                      *network.SecurityGroupsClient
+	SecurityPartnerProviderClient            *network.SecurityPartnerProvidersClient
+	SecurityRuleClient                       *network.SecurityRulesClient
+	ServiceEndpointPoliciesClient            *network.ServiceEndpointPoliciesClient
+	ServiceEndpointPolicyDefinitionsClient   *network.ServiceEndpointPolicyDefinitionsClient
+	ServiceTagsClient                        *network.ServiceTagsClient
+	SubnetsClient                            *network.SubnetsClient
+	NatGatewayClient                         *network.NatGatewaysClient
+	VirtualHubBgpConnectionClient            *network.VirtualHubBgpConnectionClient
+	VirtualHubIPClient                       *network.VirtualHubIPConfigurationClient
+	VnetGatewayConnectionsClient             *network.VirtualNetworkGatewayConnectionsClient
+	VnetGatewayClient                        *network.VirtualNetworkGatewaysClient
+	VnetGatewayNatRuleClient                 *network.VirtualNetworkGatewayNatRulesClient
+	VnetClient                               *network.VirtualNetworksClient
+	VnetPeeringsClient                       *network.VirtualNetworkPeeringsClient
+	VirtualWanClient                         *network.VirtualWansClient
+	VirtualHubClient                         *network.VirtualHubsClient
+	VpnConnectionsClient                     *network.VpnConnectionsClient
+	VpnGatewaysClient                        *network.VpnGatewaysClient
+	VpnServerConfigurationsClient            *network.VpnServerConfigurationsClient
+	VpnSitesClient                           *network.VpnSitesClient
+	WatcherClient                            *network.WatchersClient
+	WebApplicationFirewallPoliciesClient     *network.WebApplicationFirewallPoliciesClient
+	PrivateDnsZoneGroupClient                *network.PrivateDNSZoneGroupsClient
+	PrivateLinkServiceClient                 *network.PrivateLinkServicesClient
+	ServiceAssociationLinkClient             *network.ServiceAssociationLinksClient
+	ResourceNavigationLinkClient             *network.ResourceNavigationLinksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -69,6 +93,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	ApplicationSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ApplicationSecurityGroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	AvailableDelegationsClient := network.NewAvailableDelegationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&AvailableDelegationsClient.Client, o.ResourceManagerAuthorizer)
+
+	AvailableResourceGroupDelegationsClient := network.NewAvailableResourceGroupDelegationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&AvailableResourceGroupDelegationsClient.Client, o.ResourceManagerAuthorizer)
+
 	BastionHostsClient := network.NewBastionHostsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&BastionHostsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -81,13 +111,13 @@ func NewClient(o *common.ClientOptions) *Client {
 	ExpressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ExpressRouteAuthsClient.Client, o.ResourceManagerAuthorizer)
 
-	ExpressRouteCircuitsClient := network.NewExpressRouteCircuitsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	ExpressRouteCircuitsClient := expressroutecircuits.NewExpressRouteCircuitsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&ExpressRouteCircuitsClient.Client, o.ResourceManagerAuthorizer)
 
 	ExpressRouteCircuitConnectionClient := network.NewExpressRouteCircuitConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ExpressRouteCircuitConnectionClient.Client, o.ResourceManagerAuthorizer)
 
-	ExpressRouteConnectionsClient := network.NewExpressRouteConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	ExpressRouteConnectionsClient := expressrouteconnections.NewExpressRouteConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&ExpressRouteConnectionsClient.Client, o.ResourceManagerAuthorizer)
 
 	ExpressRouteGatewaysClient := network.NewExpressRouteGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -96,6 +126,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	ExpressRoutePeeringsClient := network.NewExpressRouteCircuitPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ExpressRoutePeeringsClient.Client, o.ResourceManagerAuthorizer)
 
+	ExpressRoutePortAuthorizationsClient := expressrouteportauthorizations.NewExpressRoutePortAuthorizationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ExpressRoutePortAuthorizationsClient.Client, o.ResourceManagerAuthorizer)
+
 	ExpressRoutePortsClient := network.NewExpressRoutePortsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ExpressRoutePortsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -105,7 +138,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	HubRouteTableClient := network.NewHubRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&HubRouteTableClient.Client, o.ResourceManagerAuthorizer)
 
-	HubVirtualNetworkConnectionClient := network.NewHubVirtualNetworkConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	HubVirtualNetworkConnectionClient := hubvirtualnetworkconnections.NewHubVirtualNetworkConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&HubVirtualNetworkConnectionClient.Client, o.ResourceManagerAuthorizer)
 
 	InterfacesClient := network.NewInterfacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -117,6 +150,27 @@ func NewClient(o *common.ClientOptions) *Client {
 	LocalNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LocalNetworkGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
+	ManagerAdminRuleCollectionsClient := adminrulecollections.NewAdminRuleCollectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerAdminRuleCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerAdminRulesClient := adminrules.NewAdminRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerAdminRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerConnectivityConfigurationsClient := connectivityconfigurations.NewConnectivityConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerConnectivityConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerNetworkGroupsClient := networkgroups.NewNetworkGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerNetworkGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagersClient := networkmanagers.NewNetworkManagersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagersClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerSecurityAdminConfigurationsClient := securityadminconfigurations.NewSecurityAdminConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerSecurityAdminConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagerStaticMembersClient := staticmembers.NewStaticMembersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagerStaticMembersClient.Client, o.ResourceManagerAuthorizer)
+
 	NatRuleClient := network.NewNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatRuleClient.Client, o.ResourceManagerAuthorizer)
 
@@ -159,6 +213,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	RouteFiltersClient := network.NewRouteFiltersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteFiltersClient.Client, o.ResourceManagerAuthorizer)
 
+	RouteMapsClient := routemaps.NewRouteMapsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RouteMapsClient.Client, o.ResourceManagerAuthorizer)
+
 	RouteTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteTablesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -192,6 +249,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	VnetGatewayClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetGatewayClient.Client, o.ResourceManagerAuthorizer)
 
+	VnetGatewayNatRuleClient := network.NewVirtualNetworkGatewayNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VnetGatewayNatRuleClient.Client, o.ResourceManagerAuthorizer)
+
 	NatGatewayClient := network.NewNatGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatGatewayClient.Client, o.ResourceManagerAuthorizer)
 
@@ -226,59 +286,71 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&ResourceNavigationLinkClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
-		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
-		BastionHostsClient:                     &BastionHostsClient,
-		ConnectionMonitorsClient:               &ConnectionMonitorsClient,
-		DDOSProtectionPlansClient:              &DDOSProtectionPlansClient,
-		ExpressRouteAuthsClient:                &ExpressRouteAuthsClient,
-		ExpressRouteCircuitsClient:             &ExpressRouteCircuitsClient,
-		ExpressRouteCircuitConnectionClient:    &ExpressRouteCircuitConnectionClient,
-		ExpressRouteConnectionsClient:          &ExpressRouteConnectionsClient,
-		ExpressRouteGatewaysClient:             &ExpressRouteGatewaysClient,
-		ExpressRoutePeeringsClient:             &ExpressRoutePeeringsClient,
-		ExpressRoutePortsClient:                &ExpressRoutePortsClient,
-		FlowLogsClient:                         &FlowLogsClient,
-		HubRouteTableClient:                    &HubRouteTableClient,
-		HubVirtualNetworkConnectionClient:      &HubVirtualNetworkConnectionClient,
-		InterfacesClient:                       &InterfacesClient,
-		IPGroupsClient:                         &IpGroupsClient,
-		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
-		NatRuleClient:                          &NatRuleClient,
-		PointToSiteVpnGatewaysClient:           &pointToSiteVpnGatewaysClient,
-		ProfileClient:                          &ProfileClient,
-		PacketCapturesClient:                   &PacketCapturesClient,
-		PrivateEndpointClient:                  &PrivateEndpointClient,
-		PublicIPsClient:                        &PublicIPsClient,
-		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
-		RoutesClient:                           &RoutesClient,
-		RouteFiltersClient:                     &RouteFiltersClient,
-		RouteTablesClient:                      &RouteTablesClient,
-		SecurityGroupClient:                    &SecurityGroupClient,
-		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
-		SecurityRuleClient:                     &SecurityRuleClient,
-		ServiceEndpointPoliciesClient:          &ServiceEndpointPoliciesClient,
-		ServiceEndpointPolicyDefinitionsClient: &ServiceEndpointPolicyDefinitionsClient,
-		ServiceTagsClient:                      &ServiceTagsClient,
-		SubnetsClient:                          &SubnetsClient,
-		NatGatewayClient:                       &NatGatewayClient,
-		VirtualHubBgpConnectionClient:          &VirtualHubBgpConnectionClient,
-		VirtualHubIPClient:                     &VirtualHubIPClient,
-		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
-		VnetGatewayClient:                      &VnetGatewayClient,
-		VnetClient:                             &VnetClient,
-		VnetPeeringsClient:                     &VnetPeeringsClient,
-		VirtualWanClient:                       &VirtualWanClient,
-		VirtualHubClient:                       &VirtualHubClient,
-		VpnConnectionsClient:                   &vpnConnectionsClient,
-		VpnGatewaysClient:                      &vpnGatewaysClient,
-		VpnServerConfigurationsClient:          &vpnServerConfigurationsClient,
-		VpnSitesClient:                         &vpnSitesClient,
-		WatcherClient:                          &WatcherClient,
-		WebApplicationFirewallPoliciesClient:   &WebApplicationFirewallPoliciesClient,
-		PrivateDnsZoneGroupClient:              &PrivateDnsZoneGroupClient,
-		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
-		ServiceAssociationLinkClient:           &ServiceAssociationLinkClient,
-		ResourceNavigationLinkClient:           &ResourceNavigationLinkClient,
+		ApplicationGatewaysClient:                &ApplicationGatewaysClient,
+		ApplicationSecurityGroupsClient:          &ApplicationSecurityGroupsClient,
+		AvailableDelegationsClient:               &AvailableDelegationsClient,
+		AvailableResourceGroupDelegationsClient:  &AvailableResourceGroupDelegationsClient,
+		BastionHostsClient:                       &BastionHostsClient,
+		ConnectionMonitorsClient:                 &ConnectionMonitorsClient,
+		DDOSProtectionPlansClient:                &DDOSProtectionPlansClient,
+		ExpressRouteAuthsClient:                  &ExpressRouteAuthsClient,
+		ExpressRouteCircuitsClient:               &ExpressRouteCircuitsClient,
+		ExpressRouteCircuitConnectionClient:      &ExpressRouteCircuitConnectionClient,
+		ExpressRouteConnectionsClient:            &ExpressRouteConnectionsClient,
+		ExpressRouteGatewaysClient:               &ExpressRouteGatewaysClient,
+		ExpressRoutePeeringsClient:               &ExpressRoutePeeringsClient,
+		ExpressRoutePortAuthorizationsClient:     &ExpressRoutePortAuthorizationsClient,
+		ExpressRoutePortsClient:                  &ExpressRoutePortsClient,
+		FlowLogsClient:                           &FlowLogsClient,
+		HubRouteTableClient:                      &HubRouteTableClient,
+		HubVirtualNetworkConnectionClient:        &HubVirtualNetworkConnectionClient,
+		InterfacesClient:                         &InterfacesClient,
+		IPGroupsClient:                           &IpGroupsClient,
+		LocalNetworkGatewaysClient:               &LocalNetworkGatewaysClient,
+		ManagerAdminRuleCollectionsClient:        &ManagerAdminRuleCollectionsClient,
+		ManagerAdminRulesClient:                  &ManagerAdminRulesClient,
+		ManagerConnectivityConfigurationsClient:  &ManagerConnectivityConfigurationsClient,
+		ManagerNetworkGroupsClient:               &ManagerNetworkGroupsClient,
+		ManagersClient:                           &ManagersClient,
+		ManagerSecurityAdminConfigurationsClient: &ManagerSecurityAdminConfigurationsClient,
+		ManagerStaticMembersClient:               &ManagerStaticMembersClient,
+		NatRuleClient:                            &NatRuleClient,
+		PointToSiteVpnGatewaysClient:             &pointToSiteVpnGatewaysClient,
+		ProfileClient:                            &ProfileClient,
+		PacketCapturesClient:                     &PacketCapturesClient,
+		PrivateEndpointClient:                    &PrivateEndpointClient,
+		PublicIPsClient:                          &PublicIPsClient,
+		PublicIPPrefixesClient:                   &PublicIPPrefixesClient,
+		RoutesClient:                             &RoutesClient,
+		RouteFiltersClient:                       &RouteFiltersClient,
+		RouteMapsClient:                          &RouteMapsClient,
+		RouteTablesClient:                        &RouteTablesClient,
+		SecurityGroupClient:                      &SecurityGroupClient,
+		SecurityPartnerProviderClient:            &SecurityPartnerProviderClient,
+		SecurityRuleClient:                       &SecurityRuleClient,
+		ServiceEndpointPoliciesClient:            &ServiceEndpointPoliciesClient,
+		ServiceEndpointPolicyDefinitionsClient:   &ServiceEndpointPolicyDefinitionsClient,
+		ServiceTagsClient:                        &ServiceTagsClient,
+		SubnetsClient:                            &SubnetsClient,
+		NatGatewayClient:                         &NatGatewayClient,
+		VirtualHubBgpConnectionClient:            &VirtualHubBgpConnectionClient,
+		VirtualHubIPClient:                       &VirtualHubIPClient,
+		VnetGatewayConnectionsClient:             &VnetGatewayConnectionsClient,
+		VnetGatewayClient:                        &VnetGatewayClient,
+		VnetGatewayNatRuleClient:                 &VnetGatewayNatRuleClient,
+		VnetClient:                               &VnetClient,
+		VnetPeeringsClient:                       &VnetPeeringsClient,
+		VirtualWanClient:                         &VirtualWanClient,
+		VirtualHubClient:                         &VirtualHubClient,
+		VpnConnectionsClient:                     &vpnConnectionsClient,
+		VpnGatewaysClient:                        &vpnGatewaysClient,
+		VpnServerConfigurationsClient:            &vpnServerConfigurationsClient,
+		VpnSitesClient:                           &vpnSitesClient,
+		WatcherClient:                            &WatcherClient,
+		WebApplicationFirewallPoliciesClient:     &WebApplicationFirewallPoliciesClient,
+		PrivateDnsZoneGroupClient:                &PrivateDnsZoneGroupClient,
+		PrivateLinkServiceClient:                 &PrivateLinkServiceClient,
+		ServiceAssociationLinkClient:             &ServiceAssociationLinkClient,
+		ResourceNavigationLinkClient:             &ResourceNavigationLinkClient,
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressroutecircuits"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -129,12 +130,12 @@ func resourceExpressRouteCircuitConnectionCreate(d *pluginsdk.ResourceData, meta
 	if v, ok := d.GetOk("address_prefix_ipv6"); ok {
 		circuitId := parse.NewExpressRouteCircuitID(circuitPeeringId.SubscriptionId, circuitPeeringId.ResourceGroup, circuitPeeringId.ExpressRouteCircuitName)
 
-		circuit, err := circuitClient.Get(ctx, circuitId.ResourceGroup, circuitId.Name)
+		circuit, err := circuitClient.Get(ctx, expressroutecircuits.NewExpressRouteCircuitID(circuitId.SubscriptionId, circuitId.ResourceGroup, circuitId.Name))
 		if err != nil {
 			return fmt.Errorf("retrieving %s: %+v", circuitId, err)
 		}
 
-		if circuit.ExpressRouteCircuitPropertiesFormat != nil && circuit.ExpressRouteCircuitPropertiesFormat.ExpressRoutePort != nil {
+		if model := circuit.Model; model != nil && model.Properties != nil && model.Properties.ExpressRoutePort != nil {
 			return fmt.Errorf("`address_prefix_ipv6` cannot be set when ExpressRoute Circuit Connection with ExpressRoute Circuit based on ExpressRoute Port")
 		} else {
 			expressRouteCircuitConnectionParameters.ExpressRouteCircuitConnectionPropertiesFormat.Ipv6CircuitConnectionConfig = &network.Ipv6CircuitConnectionConfig{
@@ -250,12 +251,12 @@ func resourceExpressRouteCircuitConnectionUpdate(d *pluginsdk.ResourceData, meta
 	if v, ok := d.GetOk("address_prefix_ipv6"); ok {
 		circuitId := parse.NewExpressRouteCircuitID(circuitPeeringId.SubscriptionId, circuitPeeringId.ResourceGroup, circuitPeeringId.ExpressRouteCircuitName)
 
-		circuit, err := circuitClient.Get(ctx, circuitId.ResourceGroup, circuitId.Name)
+		circuit, err := circuitClient.Get(ctx, expressroutecircuits.NewExpressRouteCircuitID(circuitId.SubscriptionId, circuitId.ResourceGroup, circuitId.Name))
 		if err != nil {
 			return fmt.Errorf("retrieving %s: %+v", circuitId, err)
 		}
 
-		if circuit.ExpressRouteCircuitPropertiesFormat != nil && circuit.ExpressRouteCircuitPropertiesFormat.ExpressRoutePort != nil {
+		if model := circuit.Model; model != nil && model.Properties != nil && model.Properties.ExpressRoutePort != nil {
 			return fmt.Errorf("`address_prefix_ipv6` cannot be set when ExpressRoute Circuit Connection with ExpressRoute Circuit based on ExpressRoute Port")
 		} else {
 			expressRouteCircuitConnectionParameters.ExpressRouteCircuitConnectionPropertiesFormat.Ipv6CircuitConnectionConfig = &network.Ipv6CircuitConnectionConfig{
@@ -4,15 +4,14 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressroutecircuits"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourceExpressRouteCircuit() *pluginsdk.Resource {
@@ -129,11 +128,11 @@ func dataSourceExpressRouteCircuitRead(d *pluginsdk.ResourceData, meta interface
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewExpressRouteCircuitID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := expressroutecircuits.NewExpressRouteCircuitID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("%s was not found", id)
 		}
 		return fmt.Errorf("making Read request on %s: %+v", id, err)
@@ -141,41 +140,52 @@ func dataSourceExpressRouteCircuitRead(d *pluginsdk.ResourceData, meta interface
 
 	d.SetId(id.ID())
 
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
 
-	if properties := resp.ExpressRouteCircuitPropertiesFormat; properties != nil {
-		peerings := flattenExpressRouteCircuitPeerings(properties.Peerings)
-		if err := d.Set("peerings", peerings); err != nil {
-			return err
-		}
+		if properties := model.Properties; properties != nil {
+			peerings := flattenExpressRouteCircuitPeerings(properties.Peerings)
+			if err := d.Set("peerings", peerings); err != nil {
+				return err
+			}
 
-		d.Set("service_key", properties.ServiceKey)
-		d.Set("service_provider_provisioning_state", properties.ServiceProviderProvisioningState)
+			serviceProviderProvisioningState := ""
+			if properties.ServiceProviderProvisioningState != nil {
+				serviceProviderProvisioningState = string(*properties.ServiceProviderProvisioningState)
+			}
+			d.Set("service_key", properties.ServiceKey)
+			d.Set("service_provider_provisioning_state", serviceProviderProvisioningState)
 
-		if serviceProviderProperties := flattenExpressRouteCircuitServiceProviderProperties(properties.ServiceProviderProperties); serviceProviderProperties != nil {
-			if err := d.Set("service_provider_properties", serviceProviderProperties); err != nil {
-				return fmt.Errorf("setting `service_provider_properties`: %+v", err)
+			if serviceProviderProperties := flattenExpressRouteCircuitServiceProviderProperties(properties.ServiceProviderProperties); serviceProviderProperties != nil {
+				if err := d.Set("service_provider_properties", serviceProviderProperties); err != nil {
+					return fmt.Errorf("setting `service_provider_properties`: %+v", err)
+				}
 			}
 		}
-	}
 
-	sku := flattenExpressRouteCircuitSku(resp.Sku)
-	if err := d.Set("sku", sku); err != nil {
-		return fmt.Errorf("setting `sku`: %+v", err)
+		sku := flattenExpressRouteCircuitSku(model.Sku)
+		if err := d.Set("sku", sku); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
 	}
 
 	return nil
 }
 
-func flattenExpressRouteCircuitPeerings(input *[]network.ExpressRouteCircuitPeering) []interface{} {
+func flattenExpressRouteCircuitPeerings(input *[]expressroutecircuits.ExpressRouteCircuitPeering) []interface{} {
 	peerings := make([]interface{}, 0)
 
 	if input != nil {
 		for _, peering := range *input {
-			props := peering.ExpressRouteCircuitPeeringPropertiesFormat
+			props := peering.Properties
+			if props == nil {
+				continue
+			}
 			p := make(map[string]interface{})
 
-			p["peering_type"] = string(props.PeeringType)
+			if peeringType := props.PeeringType; peeringType != nil {
+				p["peering_type"] = string(*peeringType)
+			}
 
 			if primaryPeerAddressPrefix := props.PrimaryPeerAddressPrefix; primaryPeerAddressPrefix != nil {
 				p["primary_peer_address_prefix"] = *primaryPeerAddressPrefix
@@ -193,7 +203,7 @@ func flattenExpressRouteCircuitPeerings(input *[]network.ExpressRouteCircuitPeer
 				p["peer_asn"] = *peerAsn
 			}
 
-			if vlanID := props.VlanID; vlanID != nil {
+			if vlanID := props.VlanId; vlanID != nil {
 				p["vlan_id"] = *vlanID
 			}
 
@@ -208,7 +218,7 @@ func flattenExpressRouteCircuitPeerings(input *[]network.ExpressRouteCircuitPeer
 	return peerings
 }
 
-func flattenExpressRouteCircuitServiceProviderProperties(input *network.ExpressRouteCircuitServiceProviderProperties) []interface{} {
+func flattenExpressRouteCircuitServiceProviderProperties(input *expressroutecircuits.ExpressRouteCircuitServiceProviderProperties) []interface{} {
 	serviceProviderProperties := make([]interface{}, 0)
 
 	if input != nil {
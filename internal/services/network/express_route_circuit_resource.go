@@ -6,17 +6,17 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressroutecircuits"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -72,10 +72,10 @@ func resourceExpressRouteCircuit() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ExpressRouteCircuitSkuTierBasic),
-								string(network.ExpressRouteCircuitSkuTierLocal),
-								string(network.ExpressRouteCircuitSkuTierStandard),
-								string(network.ExpressRouteCircuitSkuTierPremium),
+								string(expressroutecircuits.ExpressRouteCircuitSkuTierBasic),
+								string(expressroutecircuits.ExpressRouteCircuitSkuTierLocal),
+								string(expressroutecircuits.ExpressRouteCircuitSkuTierStandard),
+								string(expressroutecircuits.ExpressRouteCircuitSkuTierPremium),
 							}, !features.ThreePointOhBeta()),
 							DiffSuppressFunc: suppress.CaseDifferenceV2Only,
 						},
@@ -84,8 +84,8 @@ func resourceExpressRouteCircuit() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ExpressRouteCircuitSkuFamilyMeteredData),
-								string(network.ExpressRouteCircuitSkuFamilyUnlimitedData),
+								string(expressroutecircuits.ExpressRouteCircuitSkuFamilyMeteredData),
+								string(expressroutecircuits.ExpressRouteCircuitSkuFamilyUnlimitedData),
 							}, !features.ThreePointOhBeta()),
 							DiffSuppressFunc: suppress.CaseDifferenceV2Only,
 						},
@@ -140,6 +140,14 @@ func resourceExpressRouteCircuit() *pluginsdk.Resource {
 				ValidateFunc:  validate.ExpressRoutePortID,
 			},
 
+			"authorization_key": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"express_route_port_id"},
+			},
+
 			"service_provider_provisioning_state": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -151,7 +159,7 @@ func resourceExpressRouteCircuit() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
-			"tags": tags.Schema(),
+			"tags": commonschema.Tags(),
 		},
 	}
 }
@@ -164,25 +172,25 @@ func resourceExpressRouteCircuitCreateUpdate(d *pluginsdk.ResourceData, meta int
 
 	log.Printf("[INFO] preparing arguments for Azure ARM ExpressRoute Circuit creation.")
 
-	id := parse.NewExpressRouteCircuitID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := expressroutecircuits.NewExpressRouteCircuitID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	locks.ByName(id.Name, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.Name, expressRouteCircuitResourceName)
+	locks.ByName(id.CircuitName, expressRouteCircuitResourceName)
+	defer locks.UnlockByName(id.CircuitName, expressRouteCircuitResourceName)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s : %s", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_express_route_circuit", id.ID())
 		}
 	}
 
-	location := azure.NormalizeLocation(d.Get("location").(string))
+	location := location.Normalize(d.Get("location").(string))
 	sku := expandExpressRouteCircuitSku(d)
 	t := d.Get("tags").(map[string]interface{})
 	allowRdfeOps := d.Get("allow_classic_operations").(bool)
@@ -192,59 +200,58 @@ func resourceExpressRouteCircuitCreateUpdate(d *pluginsdk.ResourceData, meta int
 	// the express route circuit. We'll get and update the resource in place as per https://aka.ms/erRefresh
 	// We also want to keep track of the resource obtained from the api and pass down any attributes not
 	// managed by Terraform.
-	erc := network.ExpressRouteCircuit{}
+	erc := expressroutecircuits.ExpressRouteCircuit{}
 	if !d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(erc.Response) {
-				return fmt.Errorf("checking for presence of existing %s : %s", id, err)
-			}
+			return fmt.Errorf("retrieving %s : %s", id, err)
 		}
-
-		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing)
-		if err != nil {
-			return fmt.Errorf("Creating/Updating %s : %+v", id, err)
+		if existing.Model == nil {
+			return fmt.Errorf("retrieving %s: `model` was nil", id)
 		}
 
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Creating/Updating %s: %+v", id, err)
+		if err := client.CreateOrUpdateThenPoll(ctx, id, *existing.Model); err != nil {
+			return fmt.Errorf("Creating/Updating %s : %+v", id, err)
 		}
-		erc = existing
+		erc = *existing.Model
 	}
 
-	erc.Name = &id.Name
+	erc.Name = &id.CircuitName
 	erc.Location = &location
 	erc.Sku = sku
 	erc.Tags = expandedTags
 
-	if !d.IsNewResource() {
-		erc.ExpressRouteCircuitPropertiesFormat.AllowClassicOperations = &allowRdfeOps
+	if !d.IsNewResource() && erc.Properties != nil {
+		erc.Properties.AllowClassicOperations = &allowRdfeOps
 	} else {
-		erc.ExpressRouteCircuitPropertiesFormat = &network.ExpressRouteCircuitPropertiesFormat{}
+		erc.Properties = &expressroutecircuits.ExpressRouteCircuitPropertiesFormat{}
 
 		// ServiceProviderProperties and expressRoutePorts/bandwidthInGbps properties are mutually exclusive
 		if _, ok := d.GetOk("express_route_port_id"); ok {
-			erc.ExpressRouteCircuitPropertiesFormat.ExpressRoutePort = &network.SubResource{}
+			erc.Properties.ExpressRoutePort = &expressroutecircuits.SubResource{}
 		} else {
-			erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties = &network.ExpressRouteCircuitServiceProviderProperties{}
+			erc.Properties.ServiceProviderProperties = &expressroutecircuits.ExpressRouteCircuitServiceProviderProperties{}
 		}
 	}
 
-	if erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties != nil {
-		erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.ServiceProviderName = utils.String(d.Get("service_provider_name").(string))
-		erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.PeeringLocation = utils.String(d.Get("peering_location").(string))
-		erc.ExpressRouteCircuitPropertiesFormat.ServiceProviderProperties.BandwidthInMbps = utils.Int32(int32(d.Get("bandwidth_in_mbps").(int)))
+	if erc.Properties.ServiceProviderProperties != nil {
+		erc.Properties.ServiceProviderProperties.ServiceProviderName = utils.String(d.Get("service_provider_name").(string))
+		erc.Properties.ServiceProviderProperties.PeeringLocation = utils.String(d.Get("peering_location").(string))
+		erc.Properties.ServiceProviderProperties.BandwidthInMbps = utils.Int64(int64(d.Get("bandwidth_in_mbps").(int)))
 	} else {
-		erc.ExpressRouteCircuitPropertiesFormat.ExpressRoutePort.ID = utils.String(d.Get("express_route_port_id").(string))
-		erc.ExpressRouteCircuitPropertiesFormat.BandwidthInGbps = utils.Float(d.Get("bandwidth_in_gbps").(float64))
-	}
+		if erc.Properties.ExpressRoutePort == nil {
+			erc.Properties.ExpressRoutePort = &expressroutecircuits.SubResource{}
+		}
+		erc.Properties.ExpressRoutePort.Id = utils.String(d.Get("express_route_port_id").(string))
+		erc.Properties.BandwidthInGbps = utils.Float(d.Get("bandwidth_in_gbps").(float64))
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, erc)
-	if err != nil {
-		return fmt.Errorf("Creating/Updating %s: %+v", id, err)
+		// the authorization key is only required when the ExpressRoute Port belongs to another subscription
+		if v, ok := d.GetOk("authorization_key"); ok {
+			erc.Properties.AuthorizationKey = utils.String(v.(string))
+		}
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, id, erc); err != nil {
 		return fmt.Errorf("Creating/Updating %s: %+v", id, err)
 	}
 
@@ -253,13 +260,13 @@ func resourceExpressRouteCircuitCreateUpdate(d *pluginsdk.ResourceData, meta int
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:                   []string{"NotFound"},
 		Target:                    []string{"Exists"},
-		Refresh:                   expressRouteCircuitCreationRefreshFunc(ctx, client, id.ResourceGroup, id.Name),
+		Refresh:                   expressRouteCircuitCreationRefreshFunc(ctx, client, id),
 		PollInterval:              3 * time.Second,
 		ContinuousTargetOccurence: 3,
 		Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
 	}
 
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("for %s to be able to be queried: %+v", id, err)
 	}
 
@@ -273,14 +280,14 @@ func resourceExpressRouteCircuitRead(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ExpressRouteCircuitID(d.Id())
+	id, err := expressroutecircuits.ParseExpressRouteCircuitID(d.Id())
 	if err != nil {
 		return fmt.Errorf("Parsing Azure Resource ID -: %+v", err)
 	}
 
-	resp, err := ercClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := ercClient.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
@@ -289,39 +296,49 @@ func resourceExpressRouteCircuitRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("name", id.CircuitName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
 
-	sku := flattenExpressRouteCircuitSku(resp.Sku)
-	if err := d.Set("sku", sku); err != nil {
-		return fmt.Errorf("setting `sku`: %+v", err)
-	}
+		sku := flattenExpressRouteCircuitSku(model.Sku)
+		if err := d.Set("sku", sku); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			if props.ExpressRoutePort != nil {
+				d.Set("bandwidth_in_gbps", props.BandwidthInGbps)
+
+				if props.ExpressRoutePort.Id != nil {
+					portID, err := parse.ExpressRoutePortID(*props.ExpressRoutePort.Id)
+					if err != nil {
+						return err
+					}
+					d.Set("express_route_port_id", portID.ID())
+				}
+			}
 
-	if resp.ExpressRoutePort != nil {
-		d.Set("bandwidth_in_gbps", resp.BandwidthInGbps)
+			if serviceProviderProps := props.ServiceProviderProperties; serviceProviderProps != nil {
+				d.Set("service_provider_name", serviceProviderProps.ServiceProviderName)
+				d.Set("peering_location", serviceProviderProps.PeeringLocation)
+				d.Set("bandwidth_in_mbps", serviceProviderProps.BandwidthInMbps)
+			}
 
-		if resp.ExpressRoutePort.ID != nil {
-			portID, err := parse.ExpressRoutePortID(*resp.ExpressRoutePort.ID)
-			if err != nil {
-				return err
+			serviceProviderProvisioningState := ""
+			if props.ServiceProviderProvisioningState != nil {
+				serviceProviderProvisioningState = string(*props.ServiceProviderProvisioningState)
 			}
-			d.Set("express_route_port_id", portID.ID())
+			d.Set("service_provider_provisioning_state", serviceProviderProvisioningState)
+			d.Set("service_key", props.ServiceKey)
+			d.Set("allow_classic_operations", props.AllowClassicOperations)
 		}
-	}
 
-	if props := resp.ServiceProviderProperties; props != nil {
-		d.Set("service_provider_name", props.ServiceProviderName)
-		d.Set("peering_location", props.PeeringLocation)
-		d.Set("bandwidth_in_mbps", props.BandwidthInMbps)
+		return tags.FlattenAndSet(d, model.Tags)
 	}
 
-	d.Set("service_provider_provisioning_state", string(resp.ServiceProviderProvisioningState))
-	d.Set("service_key", resp.ServiceKey)
-	d.Set("allow_classic_operations", resp.AllowClassicOperations)
-
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceExpressRouteCircuitDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -329,58 +346,66 @@ func resourceExpressRouteCircuitDelete(d *pluginsdk.ResourceData, meta interface
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ExpressRouteCircuitID(d.Id())
+	id, err := expressroutecircuits.ParseExpressRouteCircuitID(d.Id())
 	if err != nil {
 		return fmt.Errorf("parsing Azure Resource ID -: %+v", err)
 	}
 
-	locks.ByName(id.Name, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(id.Name, expressRouteCircuitResourceName)
+	locks.ByName(id.CircuitName, expressRouteCircuitResourceName)
+	defer locks.UnlockByName(id.CircuitName, expressRouteCircuitResourceName)
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s : %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
-	}
-
-	return err
+	return nil
 }
 
-func expandExpressRouteCircuitSku(d *pluginsdk.ResourceData) *network.ExpressRouteCircuitSku {
+func expandExpressRouteCircuitSku(d *pluginsdk.ResourceData) *expressroutecircuits.ExpressRouteCircuitSku {
 	skuSettings := d.Get("sku").([]interface{})
 	v := skuSettings[0].(map[string]interface{}) // [0] is guarded by MinItems in pluginsdk.
 	tier := v["tier"].(string)
 	family := v["family"].(string)
 	name := fmt.Sprintf("%s_%s", tier, family)
 
-	return &network.ExpressRouteCircuitSku{
+	skuTier := expressroutecircuits.ExpressRouteCircuitSkuTier(tier)
+	skuFamily := expressroutecircuits.ExpressRouteCircuitSkuFamily(family)
+
+	return &expressroutecircuits.ExpressRouteCircuitSku{
 		Name:   &name,
-		Tier:   network.ExpressRouteCircuitSkuTier(tier),
-		Family: network.ExpressRouteCircuitSkuFamily(family),
+		Tier:   &skuTier,
+		Family: &skuFamily,
 	}
 }
 
-func flattenExpressRouteCircuitSku(sku *network.ExpressRouteCircuitSku) []interface{} {
+func flattenExpressRouteCircuitSku(sku *expressroutecircuits.ExpressRouteCircuitSku) []interface{} {
 	if sku == nil {
 		return []interface{}{}
 	}
 
+	tier := ""
+	if sku.Tier != nil {
+		tier = string(*sku.Tier)
+	}
+
+	family := ""
+	if sku.Family != nil {
+		family = string(*sku.Family)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"tier":   string(sku.Tier),
-			"family": string(sku.Family),
+			"tier":   tier,
+			"family": family,
 		},
 	}
 }
 
-func expressRouteCircuitCreationRefreshFunc(ctx context.Context, client *network.ExpressRouteCircuitsClient, resGroup, name string) pluginsdk.StateRefreshFunc {
+func expressRouteCircuitCreationRefreshFunc(ctx context.Context, client *expressroutecircuits.ExpressRouteCircuitsClient, id expressroutecircuits.ExpressRouteCircuitId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, resGroup, name)
+		res, err := client.Get(ctx, id)
 		if err != nil {
-			if utils.ResponseWasNotFound(res.Response) {
+			if response.WasNotFound(res.HttpResponse) {
 				return nil, "NotFound", nil
 			}
 
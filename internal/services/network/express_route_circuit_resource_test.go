@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressroutecircuits"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -33,6 +34,7 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"bandwidthReduction":           testAccExpressRouteCircuit_bandwidthReduction,
 			"port":                         testAccExpressRouteCircuit_withExpressRoutePort,
 			"updatePort":                   testAccExpressRouteCircuit_updateExpressRoutePort,
+			"portAuthorizationKey":         testAccExpressRouteCircuit_withExpressRoutePortAuthorizationKey,
 		},
 		"PrivatePeering": {
 			"azurePrivatePeering":           testAccExpressRouteCircuitPeering_azurePrivatePeering,
@@ -296,18 +298,33 @@ func testAccExpressRouteCircuit_updateExpressRoutePort(t *testing.T) {
 	})
 }
 
+func testAccExpressRouteCircuit_withExpressRoutePortAuthorizationKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit", "test")
+	r := ExpressRouteCircuitResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withExpressRoutePortAuthorizationKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authorization_key"),
+	})
+}
+
 func (t ExpressRouteCircuitResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ExpressRouteCircuitID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := clients.Network.ExpressRouteCircuitsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network.ExpressRouteCircuitsClient.Get(ctx, expressroutecircuits.NewExpressRouteCircuitID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("reading %s: %+v", *id, err)
 	}
 
-	return utils.Bool(resp.ID != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
 }
 
 func (ExpressRouteCircuitResource) basicMeteredConfig(data acceptance.TestData) string {
@@ -611,3 +628,45 @@ resource "azurerm_express_route_circuit" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func (ExpressRouteCircuitResource) withExpressRoutePortAuthorizationKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-expressroutecircuit-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctest-ERP-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+
+resource "azurerm_express_route_port_authorization" "test" {
+  name                    = "acctest-ERPA-%[1]d"
+  resource_group_name     = azurerm_resource_group.test.name
+  express_route_port_name = azurerm_express_route_port.test.name
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-ExpressRouteCircuit-%[1]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  express_route_port_id = azurerm_express_route_port.test.id
+  bandwidth_in_gbps     = 5
+  authorization_key     = azurerm_express_route_port_authorization.test.authorization_key
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
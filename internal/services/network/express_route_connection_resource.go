@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressrouteconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/routemaps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -83,6 +85,18 @@ func resourceExpressRouteConnection() *pluginsdk.Resource {
 							AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.propagated_route_table"},
 						},
 
+						"inbound_route_map_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: routemaps.ValidateRouteMapID,
+						},
+
+						"outbound_route_map_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: routemaps.ValidateRouteMapID,
+						},
+
 						"propagated_route_table": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
@@ -141,42 +155,39 @@ func resourceExpressRouteConnectionCreate(d *pluginsdk.ResourceData, meta interf
 
 	id := parse.NewExpressRouteConnectionID(expressRouteGatewayId.SubscriptionId, expressRouteGatewayId.ResourceGroup, expressRouteGatewayId.Name, d.Get("name").(string))
 
-	existing, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
+	sdkId := expressrouteconnections.NewExpressRouteConnectionID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
+
+	existing, err := client.Get(ctx, sdkId)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return fmt.Errorf("checking for existing %s: %+v", id, err)
 		}
 	}
 
-	if !utils.ResponseWasNotFound(existing.Response) {
+	if !response.WasNotFound(existing.HttpResponse) {
 		return tf.ImportAsExistsError("azurerm_express_route_connection", id.ID())
 	}
 
-	parameters := network.ExpressRouteConnection{
-		Name: utils.String(id.Name),
-		ExpressRouteConnectionProperties: &network.ExpressRouteConnectionProperties{
-			ExpressRouteCircuitPeering: &network.ExpressRouteCircuitPeeringID{
-				ID: utils.String(d.Get("express_route_circuit_peering_id").(string)),
+	parameters := expressrouteconnections.ExpressRouteConnection{
+		Name: id.Name,
+		Properties: &expressrouteconnections.ExpressRouteConnectionProperties{
+			ExpressRouteCircuitPeering: expressrouteconnections.ExpressRouteCircuitPeeringId{
+				Id: utils.String(d.Get("express_route_circuit_peering_id").(string)),
 			},
 			EnableInternetSecurity: utils.Bool(d.Get("enable_internet_security").(bool)),
 			RoutingConfiguration:   expandExpressRouteConnectionRouting(d.Get("routing").([]interface{})),
-			RoutingWeight:          utils.Int32(int32(d.Get("routing_weight").(int))),
+			RoutingWeight:          utils.Int64(int64(d.Get("routing_weight").(int))),
 		},
 	}
 
 	if v, ok := d.GetOk("authorization_key"); ok {
-		parameters.ExpressRouteConnectionProperties.AuthorizationKey = utils.String(v.(string))
+		parameters.Properties.AuthorizationKey = utils.String(v.(string))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name, parameters)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, sdkId, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
-	}
-
 	d.SetId(id.ID())
 
 	return resourceExpressRouteConnectionRead(d, meta)
@@ -192,9 +203,9 @@ func resourceExpressRouteConnectionRead(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
+	resp, err := client.Get(ctx, expressrouteconnections.NewExpressRouteConnectionID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -204,14 +215,16 @@ func resourceExpressRouteConnectionRead(d *pluginsdk.ResourceData, meta interfac
 	d.Set("name", id.Name)
 	d.Set("express_route_gateway_id", parse.NewExpressRouteGatewayID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteGatewayName).ID())
 
-	if props := resp.ExpressRouteConnectionProperties; props != nil {
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+
 		d.Set("routing_weight", props.RoutingWeight)
 		d.Set("authorization_key", props.AuthorizationKey)
 		d.Set("enable_internet_security", props.EnableInternetSecurity)
 
 		circuitPeeringID := ""
-		if v := props.ExpressRouteCircuitPeering; v != nil {
-			circuitPeeringID = *v.ID
+		if v := props.ExpressRouteCircuitPeering.Id; v != nil {
+			circuitPeeringID = *v
 		}
 		peeringId, err := parse.ExpressRouteCircuitPeeringID(circuitPeeringID)
 		if err != nil {
@@ -241,31 +254,26 @@ func resourceExpressRouteConnectionUpdate(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
-	parameters := network.ExpressRouteConnection{
-		Name: utils.String(id.Name),
-		ExpressRouteConnectionProperties: &network.ExpressRouteConnectionProperties{
-			ExpressRouteCircuitPeering: &network.ExpressRouteCircuitPeeringID{
-				ID: utils.String(d.Get("express_route_circuit_peering_id").(string)),
+	parameters := expressrouteconnections.ExpressRouteConnection{
+		Name: id.Name,
+		Properties: &expressrouteconnections.ExpressRouteConnectionProperties{
+			ExpressRouteCircuitPeering: expressrouteconnections.ExpressRouteCircuitPeeringId{
+				Id: utils.String(d.Get("express_route_circuit_peering_id").(string)),
 			},
 			EnableInternetSecurity: utils.Bool(d.Get("enable_internet_security").(bool)),
 			RoutingConfiguration:   expandExpressRouteConnectionRouting(d.Get("routing").([]interface{})),
-			RoutingWeight:          utils.Int32(int32(d.Get("routing_weight").(int))),
+			RoutingWeight:          utils.Int64(int64(d.Get("routing_weight").(int))),
 		},
 	}
 
 	if v, ok := d.GetOk("authorization_key"); ok {
-		parameters.ExpressRouteConnectionProperties.AuthorizationKey = utils.String(v.(string))
+		parameters.Properties.AuthorizationKey = utils.String(v.(string))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name, parameters)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, expressrouteconnections.NewExpressRouteConnectionID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name), parameters); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of %s: %+v", id, err)
-	}
-
 	return resourceExpressRouteConnectionRead(d, meta)
 }
 
@@ -279,29 +287,36 @@ func resourceExpressRouteConnectionDelete(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, expressrouteconnections.NewExpressRouteConnectionID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
-func expandExpressRouteConnectionRouting(input []interface{}) *network.RoutingConfiguration {
+func expandExpressRouteConnectionRouting(input []interface{}) *expressrouteconnections.RoutingConfiguration {
 	if len(input) == 0 || input[0] == nil {
-		return &network.RoutingConfiguration{}
+		return &expressrouteconnections.RoutingConfiguration{}
 	}
 
 	v := input[0].(map[string]interface{})
-	result := network.RoutingConfiguration{}
+	result := expressrouteconnections.RoutingConfiguration{}
 
 	if associatedRouteTableId := v["associated_route_table_id"].(string); associatedRouteTableId != "" {
-		result.AssociatedRouteTable = &network.SubResource{
-			ID: utils.String(associatedRouteTableId),
+		result.AssociatedRouteTable = &expressrouteconnections.SubResource{
+			Id: utils.String(associatedRouteTableId),
+		}
+	}
+
+	if inboundRouteMapId := v["inbound_route_map_id"].(string); inboundRouteMapId != "" {
+		result.InboundRouteMap = &expressrouteconnections.SubResource{
+			Id: utils.String(inboundRouteMapId),
+		}
+	}
+
+	if outboundRouteMapId := v["outbound_route_map_id"].(string); outboundRouteMapId != "" {
+		result.OutboundRouteMap = &expressrouteconnections.SubResource{
+			Id: utils.String(outboundRouteMapId),
 		}
 	}
 
@@ -312,49 +327,67 @@ func expandExpressRouteConnectionRouting(input []interface{}) *network.RoutingCo
 	return &result
 }
 
-func expandExpressRouteConnectionPropagatedRouteTable(input []interface{}) *network.PropagatedRouteTable {
+func expandExpressRouteConnectionPropagatedRouteTable(input []interface{}) *expressrouteconnections.PropagatedRouteTable {
 	if len(input) == 0 || input[0] == nil {
-		return &network.PropagatedRouteTable{}
+		return &expressrouteconnections.PropagatedRouteTable{}
 	}
 
 	v := input[0].(map[string]interface{})
 
-	result := network.PropagatedRouteTable{}
+	result := expressrouteconnections.PropagatedRouteTable{}
 
 	if labels := v["labels"].(*pluginsdk.Set).List(); len(labels) != 0 {
 		result.Labels = utils.ExpandStringSlice(labels)
 	}
 
 	if routeTableIds := v["route_table_ids"].([]interface{}); len(routeTableIds) != 0 {
-		result.Ids = expandIDsToSubResources(routeTableIds)
+		ids := make([]expressrouteconnections.SubResource, 0)
+		for _, id := range routeTableIds {
+			ids = append(ids, expressrouteconnections.SubResource{
+				Id: utils.String(id.(string)),
+			})
+		}
+		result.Ids = &ids
 	}
 
 	return &result
 }
 
-func flattenExpressRouteConnectionRouting(input *network.RoutingConfiguration) ([]interface{}, error) {
+func flattenExpressRouteConnectionRouting(input *expressrouteconnections.RoutingConfiguration) ([]interface{}, error) {
 	if input == nil {
 		return []interface{}{}, nil
 	}
 
 	associatedRouteTableId := ""
-	if input.AssociatedRouteTable != nil && input.AssociatedRouteTable.ID != nil {
-		associatedRouteTableId = *input.AssociatedRouteTable.ID
+	if input.AssociatedRouteTable != nil && input.AssociatedRouteTable.Id != nil {
+		associatedRouteTableId = *input.AssociatedRouteTable.Id
 	}
 	routeTableId, err := parse.HubRouteTableID(associatedRouteTableId)
 	if err != nil {
 		return nil, err
 	}
 
+	inboundRouteMapId := ""
+	if input.InboundRouteMap != nil && input.InboundRouteMap.Id != nil {
+		inboundRouteMapId = *input.InboundRouteMap.Id
+	}
+
+	outboundRouteMapId := ""
+	if input.OutboundRouteMap != nil && input.OutboundRouteMap.Id != nil {
+		outboundRouteMapId = *input.OutboundRouteMap.Id
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"associated_route_table_id": routeTableId.ID(),
+			"inbound_route_map_id":      inboundRouteMapId,
+			"outbound_route_map_id":     outboundRouteMapId,
 			"propagated_route_table":    flattenExpressRouteConnectionPropagatedRouteTable(input.PropagatedRouteTables),
 		},
 	}, nil
 }
 
-func flattenExpressRouteConnectionPropagatedRouteTable(input *network.PropagatedRouteTable) []interface{} {
+func flattenExpressRouteConnectionPropagatedRouteTable(input *expressrouteconnections.PropagatedRouteTable) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
@@ -366,7 +399,13 @@ func flattenExpressRouteConnectionPropagatedRouteTable(input *network.Propagated
 
 	routeTableIds := make([]interface{}, 0)
 	if input.Ids != nil {
-		routeTableIds = flattenSubResourcesToIDs(input.Ids)
+		for _, v := range *input.Ids {
+			if v.Id == nil {
+				continue
+			}
+
+			routeTableIds = append(routeTableIds, *v.Id)
+		}
 	}
 
 	return []interface{}{
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressrouteconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -22,6 +24,7 @@ func TestAccExpressRouteConnection(t *testing.T) {
 			"requiresImport": testAccExpressRouteConnection_requiresImport,
 			"complete":       testAccExpressRouteConnection_complete,
 			"update":         testAccExpressRouteConnection_update,
+			"routeMap":       testAccExpressRouteConnection_routeMap,
 		},
 	})
 }
@@ -102,6 +105,21 @@ func testAccExpressRouteConnection_update(t *testing.T) {
 	})
 }
 
+func testAccExpressRouteConnection_routeMap(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_connection", "test")
+	r := ExpressRouteConnectionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.routeMap(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ExpressRouteConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	expressRouteConnectionClient := client.Network.ExpressRouteConnectionsClient
 	id, err := parse.ExpressRouteConnectionID(state.ID)
@@ -109,16 +127,16 @@ func (r ExpressRouteConnectionResource) Exists(ctx context.Context, client *clie
 		return nil, err
 	}
 
-	resp, err := expressRouteConnectionClient.Get(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
+	resp, err := expressRouteConnectionClient.Get(ctx, expressrouteconnections.NewExpressRouteConnectionID(id.SubscriptionId, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
 
 		return nil, fmt.Errorf("retrieving Express Route Connection %q: %+v", state.ID, err)
 	}
 
-	return utils.Bool(resp.ExpressRouteConnectionProperties != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil), nil
 }
 
 func (r ExpressRouteConnectionResource) basic(data acceptance.TestData) string {
@@ -172,6 +190,52 @@ resource "azurerm_express_route_connection" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ExpressRouteConnectionResource) routeMap(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_route_map" "test" {
+  name           = "acctestrm-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  rule {
+    name                 = "rule1"
+    next_step_if_matched = "Continue"
+
+    action {
+      type = "Add"
+
+      parameter {
+        as_path = ["22334"]
+      }
+    }
+
+    match_criterion {
+      match_condition = "Contains"
+      route_prefix    = ["10.0.0.0/8"]
+    }
+  }
+}
+
+resource "azurerm_express_route_connection" "test" {
+  name                             = "acctest-ExpressRouteConnection-%[2]d"
+  express_route_gateway_id         = azurerm_express_route_gateway.test.id
+  express_route_circuit_peering_id = azurerm_express_route_circuit_peering.test.id
+
+  routing {
+    associated_route_table_id = azurerm_virtual_hub_route_table.test.id
+    inbound_route_map_id      = azurerm_route_map.test.id
+    outbound_route_map_id     = azurerm_route_map.test.id
+
+    propagated_route_table {
+      labels          = ["label1"]
+      route_table_ids = [azurerm_virtual_hub_route_table.test.id]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ExpressRouteConnectionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,146 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/expressrouteportauthorizations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ExpressRoutePortAuthorizationResource struct{}
+
+func TestAccExpressRoutePortAuthorization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port_authorization", "test")
+	r := ExpressRoutePortAuthorizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authorization_key").Exists(),
+				check.That(data.ResourceName).Key("authorization_use_status").HasValue("Available"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExpressRoutePortAuthorization_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port_authorization", "test")
+	r := ExpressRoutePortAuthorizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccExpressRoutePortAuthorization_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_port_authorization", "test1")
+	r := ExpressRoutePortAuthorizationResource{}
+	secondResourceName := "azurerm_express_route_port_authorization.test2"
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(secondResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (ExpressRoutePortAuthorizationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := expressrouteportauthorizations.ParseExpressRoutePortAuthorizationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ExpressRoutePortAuthorizationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ExpressRoutePortAuthorizationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-erpauth-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctest-ERP-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ExpressRoutePortAuthorizationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port_authorization" "test" {
+  name                    = "acctest-ERPA-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  express_route_port_name = azurerm_express_route_port.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ExpressRoutePortAuthorizationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_port_authorization" "import" {
+  name                    = azurerm_express_route_port_authorization.test.name
+  resource_group_name     = azurerm_express_route_port_authorization.test.resource_group_name
+  express_route_port_name = azurerm_express_route_port_authorization.test.express_route_port_name
+}
+`, r.basic(data))
+}
+
+func (r ExpressRoutePortAuthorizationResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_express_route_port_authorization" "test1" {
+  name                    = "acctest-ERPA1-%[2]d"
+  resource_group_name     = azurerm_resource_group.test.name
+  express_route_port_name = azurerm_express_route_port.test.name
+}
+
+resource "azurerm_express_route_port_authorization" "test2" {
+  name                    = "acctest-ERPA2-%[2]d"
+  resource_group_name     = azurerm_resource_group.test.name
+  express_route_port_name = azurerm_express_route_port.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
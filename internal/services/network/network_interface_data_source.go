@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -170,6 +171,171 @@ func dataSourceNetworkInterface() *pluginsdk.Resource {
 				},
 			},
 
+			"include_effective_routes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"include_effective_network_security_groups": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"effective_route": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"next_hop_ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"next_hop_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"bgp_route_propagation_disabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"effective_network_security_group": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"network_security_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"associated_subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"associated_network_interface_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"security_rule": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"access": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"direction": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"source_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"destination_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -261,5 +427,165 @@ func dataSourceNetworkInterfaceRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("enable_accelerated_networking", props.EnableAcceleratedNetworking)
 	}
 
+	// the effective routes and security groups can only be retrieved when the Network Interface
+	// is attached to a running Virtual Machine, so these are opt-in
+	effectiveRoutes := make([]interface{}, 0)
+	if d.Get("include_effective_routes").(bool) {
+		future, err := client.GetEffectiveRouteTable(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving the effective routes for %s: %+v", id, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the effective routes for %s: %+v", id, err)
+		}
+
+		result, err := future.Result(*client)
+		if err != nil {
+			return fmt.Errorf("retrieving the effective routes result for %s: %+v", id, err)
+		}
+
+		effectiveRoutes = flattenNetworkInterfaceEffectiveRoutes(result.Value)
+	}
+	if err := d.Set("effective_route", effectiveRoutes); err != nil {
+		return fmt.Errorf("setting `effective_route`: %+v", err)
+	}
+
+	effectiveSecurityGroups := make([]interface{}, 0)
+	if d.Get("include_effective_network_security_groups").(bool) {
+		future, err := client.ListEffectiveNetworkSecurityGroups(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("listing the effective Network Security Groups for %s: %+v", id, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the effective Network Security Groups for %s: %+v", id, err)
+		}
+
+		result, err := future.Result(*client)
+		if err != nil {
+			return fmt.Errorf("retrieving the effective Network Security Groups result for %s: %+v", id, err)
+		}
+
+		effectiveSecurityGroups = flattenNetworkInterfaceEffectiveNetworkSecurityGroups(result.Value)
+	}
+	if err := d.Set("effective_network_security_group", effectiveSecurityGroups); err != nil {
+		return fmt.Errorf("setting `effective_network_security_group`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
+
+func flattenNetworkInterfaceEffectiveRoutes(input *[]network.EffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var name string
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		var bgpRoutePropagationDisabled bool
+		if item.DisableBgpRoutePropagation != nil {
+			bgpRoutePropagationDisabled = *item.DisableBgpRoutePropagation
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                           name,
+			"source":                         string(item.Source),
+			"state":                          string(item.State),
+			"address_prefixes":               utils.FlattenStringSlice(item.AddressPrefix),
+			"next_hop_ip_addresses":          utils.FlattenStringSlice(item.NextHopIPAddress),
+			"next_hop_type":                  string(item.NextHopType),
+			"bgp_route_propagation_disabled": bgpRoutePropagationDisabled,
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var networkSecurityGroupId string
+		if item.NetworkSecurityGroup != nil && item.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupId = *item.NetworkSecurityGroup.ID
+		}
+
+		var subnetId, networkInterfaceId string
+		if association := item.Association; association != nil {
+			if association.Subnet != nil && association.Subnet.ID != nil {
+				subnetId = *association.Subnet.ID
+			}
+
+			if association.NetworkInterface != nil && association.NetworkInterface.ID != nil {
+				networkInterfaceId = *association.NetworkInterface.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"network_security_group_id":       networkSecurityGroupId,
+			"associated_subnet_id":            subnetId,
+			"associated_network_interface_id": networkInterfaceId,
+			"security_rule":                   flattenNetworkInterfaceEffectiveSecurityRules(item.EffectiveSecurityRules),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkInterfaceEffectiveSecurityRules(input *[]network.EffectiveNetworkSecurityRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var name string
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		var priority int32
+		if item.Priority != nil {
+			priority = *item.Priority
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                                  name,
+			"access":                                string(item.Access),
+			"direction":                             string(item.Direction),
+			"priority":                              int(priority),
+			"protocol":                              string(item.Protocol),
+			"source_port_ranges":                    flattenNetworkInterfaceEffectiveSecurityRuleValues(item.SourcePortRange, item.SourcePortRanges),
+			"destination_port_ranges":               flattenNetworkInterfaceEffectiveSecurityRuleValues(item.DestinationPortRange, item.DestinationPortRanges),
+			"source_address_prefixes":               flattenNetworkInterfaceEffectiveSecurityRuleValues(item.SourceAddressPrefix, item.SourceAddressPrefixes),
+			"destination_address_prefixes":          flattenNetworkInterfaceEffectiveSecurityRuleValues(item.DestinationAddressPrefix, item.DestinationAddressPrefixes),
+			"expanded_source_address_prefixes":      utils.FlattenStringSlice(item.ExpandedSourceAddressPrefix),
+			"expanded_destination_address_prefixes": utils.FlattenStringSlice(item.ExpandedDestinationAddressPrefix),
+		})
+	}
+
+	return results
+}
+
+// the API returns either a single value or a list of values for the ports and prefixes of an effective rule
+func flattenNetworkInterfaceEffectiveSecurityRuleValues(single *string, multiple *[]string) []interface{} {
+	if multiple != nil && len(*multiple) > 0 {
+		return utils.FlattenStringSlice(multiple)
+	}
+
+	results := make([]interface{}, 0)
+	if single != nil && *single != "" {
+		results = append(results, *single)
+	}
+
+	return results
+}
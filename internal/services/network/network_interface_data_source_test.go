@@ -27,6 +27,24 @@ func TestAccDataSourceArmNetworkInterface_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceArmNetworkInterface_effectiveRoutesAndSecurityGroups(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_interface", "test")
+	r := NetworkInterfaceDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.effectiveRoutesAndSecurityGroups(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("effective_route.#").Exists(),
+				check.That(data.ResourceName).Key("effective_route.0.next_hop_type").Exists(),
+				check.That(data.ResourceName).Key("effective_network_security_group.#").HasValue("1"),
+				check.That(data.ResourceName).Key("effective_network_security_group.0.network_security_group_id").Exists(),
+				check.That(data.ResourceName).Key("effective_network_security_group.0.security_rule.#").Exists(),
+			),
+		},
+	})
+}
+
 func (NetworkInterfaceDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -37,3 +55,79 @@ data "azurerm_network_interface" "test" {
 }
 `, NetworkInterfaceResource{}.static(data))
 }
+
+func (NetworkInterfaceDataSource) effectiveRoutesAndSecurityGroups(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "AllowSSH"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "22"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_security_group_association" "test" {
+  network_interface_id      = azurerm_network_interface.test.id
+  network_security_group_id = azurerm_network_security_group.test.id
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestvm-%[2]d"
+  location                        = azurerm_resource_group.test.location
+  resource_group_name             = azurerm_resource_group.test.name
+  network_interface_ids           = [azurerm_network_interface.test.id]
+  size                            = "Standard_F2"
+  admin_username                  = "testadmin"
+  admin_password                  = "Password1234!"
+  disable_password_authentication = false
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+
+data "azurerm_network_interface" "test" {
+  name                                      = azurerm_network_interface.test.name
+  resource_group_name                       = azurerm_network_interface.test.resource_group_name
+  include_effective_routes                  = true
+  include_effective_network_security_groups = true
+
+  depends_on = [
+    azurerm_linux_virtual_machine.test,
+    azurerm_network_interface_security_group_association.test,
+  ]
+}
+`, NetworkInterfaceResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,347 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerAdminRuleResource{}
+
+type ManagerAdminRuleResource struct{}
+
+type ManagerAdminRuleModel struct {
+	Name                  string                          `tfschema:"name"`
+	AdminRuleCollectionId string                          `tfschema:"admin_rule_collection_id"`
+	Action                string                          `tfschema:"action"`
+	Direction             string                          `tfschema:"direction"`
+	Priority              int64                           `tfschema:"priority"`
+	Protocol              string                          `tfschema:"protocol"`
+	Source                []ManagerAddressPrefixItemModel `tfschema:"source"`
+	Destination           []ManagerAddressPrefixItemModel `tfschema:"destination"`
+	SourcePortRanges      []string                        `tfschema:"source_port_ranges"`
+	DestinationPortRanges []string                        `tfschema:"destination_port_ranges"`
+	Description           string                          `tfschema:"description"`
+}
+
+type ManagerAddressPrefixItemModel struct {
+	AddressPrefix     string `tfschema:"address_prefix"`
+	AddressPrefixType string `tfschema:"address_prefix_type"`
+}
+
+func (r ManagerAdminRuleResource) ResourceType() string {
+	return "azurerm_network_manager_admin_rule"
+}
+
+func (r ManagerAdminRuleResource) ModelObject() interface{} {
+	return &ManagerAdminRuleModel{}
+}
+
+func (r ManagerAdminRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return adminrules.ValidateRuleID
+}
+
+func (r ManagerAdminRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"admin_rule_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: adminrules.ValidateRuleCollectionID,
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForSecurityConfigurationRuleAccess(), false),
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForSecurityConfigurationRuleDirection(), false),
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 4096),
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForSecurityConfigurationRuleProtocol(), false),
+		},
+
+		"source": managerAddressPrefixItemSchema(),
+
+		"destination": managerAddressPrefixItemSchema(),
+
+		"source_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"destination_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerAdminRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			var state ManagerAdminRuleModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			ruleCollectionId, err := adminrules.ParseRuleCollectionID(state.AdminRuleCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := adminrules.NewRuleID(ruleCollectionId.SubscriptionId, ruleCollectionId.ResourceGroupName, ruleCollectionId.NetworkManagerName, ruleCollectionId.SecurityAdminConfigurationName, ruleCollectionId.RuleCollectionName, state.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := adminrules.AdminRule{
+				Kind:       adminrules.AdminRuleKindCustom,
+				Properties: expandManagerAdminRuleProperties(state),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerAdminRuleModel{
+				Name:                  id.RuleName,
+				AdminRuleCollectionId: adminrules.NewRuleCollectionID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Kind != adminrules.AdminRuleKindCustom {
+					return fmt.Errorf("retrieving %s: expected the `kind` to be %q but got %q", *id, string(adminrules.AdminRuleKindCustom), string(model.Kind))
+				}
+
+				if props := model.Properties; props != nil {
+					state.Action = string(props.Access)
+					state.Direction = string(props.Direction)
+					state.Priority = props.Priority
+					state.Protocol = string(props.Protocol)
+					state.Source = flattenManagerAddressPrefixItems(props.Sources)
+					state.Destination = flattenManagerAddressPrefixItems(props.Destinations)
+					state.SourcePortRanges = flattenManagerPortRanges(props.SourcePortRanges)
+					state.DestinationPortRanges = flattenManagerPortRanges(props.DestinationPortRanges)
+					if props.Description != nil {
+						state.Description = *props.Description
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ManagerAdminRuleModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			model := *existing.Model
+			model.Properties = expandManagerAdminRuleProperties(state)
+
+			if _, err := client.CreateOrUpdate(ctx, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerAdminRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id, adminrules.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func managerAddressPrefixItemSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(adminrules.PossibleValuesForAddressPrefixType(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandManagerAdminRuleProperties(input ManagerAdminRuleModel) *adminrules.AdminPropertiesFormat {
+	props := &adminrules.AdminPropertiesFormat{
+		Access:                adminrules.SecurityConfigurationRuleAccess(input.Action),
+		DestinationPortRanges: &input.DestinationPortRanges,
+		Destinations:          expandManagerAddressPrefixItems(input.Destination),
+		Direction:             adminrules.SecurityConfigurationRuleDirection(input.Direction),
+		Priority:              input.Priority,
+		Protocol:              adminrules.SecurityConfigurationRuleProtocol(input.Protocol),
+		SourcePortRanges:      &input.SourcePortRanges,
+		Sources:               expandManagerAddressPrefixItems(input.Source),
+	}
+	if input.Description != "" {
+		props.Description = utils.String(input.Description)
+	}
+
+	return props
+}
+
+func expandManagerAddressPrefixItems(input []ManagerAddressPrefixItemModel) *[]adminrules.AddressPrefixItem {
+	results := make([]adminrules.AddressPrefixItem, 0)
+	for _, v := range input {
+		addressPrefixType := adminrules.AddressPrefixType(v.AddressPrefixType)
+		results = append(results, adminrules.AddressPrefixItem{
+			AddressPrefix:     utils.String(v.AddressPrefix),
+			AddressPrefixType: &addressPrefixType,
+		})
+	}
+	return &results
+}
+
+func flattenManagerAddressPrefixItems(input *[]adminrules.AddressPrefixItem) []ManagerAddressPrefixItemModel {
+	results := make([]ManagerAddressPrefixItemModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		item := ManagerAddressPrefixItemModel{}
+		if v.AddressPrefix != nil {
+			item.AddressPrefix = *v.AddressPrefix
+		}
+		if v.AddressPrefixType != nil {
+			item.AddressPrefixType = string(*v.AddressPrefixType)
+		}
+		results = append(results, item)
+	}
+	return results
+}
+
+func flattenManagerPortRanges(input *[]string) []string {
+	if input == nil {
+		return make([]string, 0)
+	}
+	return *input
+}
@@ -0,0 +1,387 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerConnectivityConfigurationResource{}
+
+type ManagerConnectivityConfigurationResource struct{}
+
+type ManagerConnectivityConfigurationModel struct {
+	Name                         string                              `tfschema:"name"`
+	NetworkManagerId             string                              `tfschema:"network_manager_id"`
+	ConnectivityTopology         string                              `tfschema:"connectivity_topology"`
+	AppliesToGroups              []ManagerConnectivityGroupItemModel `tfschema:"applies_to_group"`
+	Hub                          []ManagerHubModel                   `tfschema:"hub"`
+	DeleteExistingPeeringEnabled bool                                `tfschema:"delete_existing_peering_enabled"`
+	GlobalMeshEnabled            bool                                `tfschema:"global_mesh_enabled"`
+	Description                  string                              `tfschema:"description"`
+}
+
+type ManagerConnectivityGroupItemModel struct {
+	NetworkGroupId    string `tfschema:"network_group_id"`
+	GroupConnectivity string `tfschema:"group_connectivity"`
+	GlobalMeshEnabled bool   `tfschema:"global_mesh_enabled"`
+	UseHubGateway     bool   `tfschema:"use_hub_gateway"`
+}
+
+type ManagerHubModel struct {
+	ResourceId   string `tfschema:"resource_id"`
+	ResourceType string `tfschema:"resource_type"`
+}
+
+func (r ManagerConnectivityConfigurationResource) ResourceType() string {
+	return "azurerm_network_manager_connectivity_configuration"
+}
+
+func (r ManagerConnectivityConfigurationResource) ModelObject() interface{} {
+	return &ManagerConnectivityConfigurationModel{}
+}
+
+func (r ManagerConnectivityConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectivityconfigurations.ValidateConnectivityConfigurationID
+}
+
+func (r ManagerConnectivityConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: connectivityconfigurations.ValidateNetworkManagerID,
+		},
+
+		"connectivity_topology": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(connectivityconfigurations.PossibleValuesForConnectivityTopology(), false),
+		},
+
+		"applies_to_group": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network_group_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: networkgroups.ValidateNetworkGroupID,
+					},
+
+					"group_connectivity": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(connectivityconfigurations.PossibleValuesForGroupConnectivity(), false),
+					},
+
+					"global_mesh_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"use_hub_gateway": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"hub": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"resource_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"delete_existing_peering_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"global_mesh_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerConnectivityConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			var state ManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId, err := connectivityconfigurations.ParseNetworkManagerID(state.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := connectivityconfigurations.NewConnectivityConfigurationID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, state.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := connectivityconfigurations.ConnectivityConfiguration{
+				Properties: expandManagerConnectivityConfigurationProperties(state),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerConnectivityConfigurationModel{
+				Name:             id.ConnectivityConfigurationName,
+				NetworkManagerId: connectivityconfigurations.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ConnectivityTopology = string(props.ConnectivityTopology)
+					state.AppliesToGroups = flattenManagerConnectivityGroupItems(props.AppliesToGroups)
+					state.Hub = flattenManagerHubs(props.Hubs)
+					if props.DeleteExistingPeering != nil {
+						state.DeleteExistingPeeringEnabled = *props.DeleteExistingPeering == connectivityconfigurations.DeleteExistingPeeringTrue
+					}
+					if props.IsGlobal != nil {
+						state.GlobalMeshEnabled = *props.IsGlobal == connectivityconfigurations.IsGlobalTrue
+					}
+					if props.Description != nil {
+						state.Description = *props.Description
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			model := *existing.Model
+			model.Properties = expandManagerConnectivityConfigurationProperties(state)
+
+			if _, err := client.CreateOrUpdate(ctx, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerConnectivityConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id, connectivityconfigurations.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandManagerConnectivityConfigurationProperties(input ManagerConnectivityConfigurationModel) *connectivityconfigurations.ConnectivityConfigurationProperties {
+	deleteExistingPeering := connectivityconfigurations.DeleteExistingPeeringFalse
+	if input.DeleteExistingPeeringEnabled {
+		deleteExistingPeering = connectivityconfigurations.DeleteExistingPeeringTrue
+	}
+
+	isGlobal := connectivityconfigurations.IsGlobalFalse
+	if input.GlobalMeshEnabled {
+		isGlobal = connectivityconfigurations.IsGlobalTrue
+	}
+
+	props := &connectivityconfigurations.ConnectivityConfigurationProperties{
+		AppliesToGroups:       expandManagerConnectivityGroupItems(input.AppliesToGroups),
+		ConnectivityTopology:  connectivityconfigurations.ConnectivityTopology(input.ConnectivityTopology),
+		DeleteExistingPeering: &deleteExistingPeering,
+		Hubs:                  expandManagerHubs(input.Hub),
+		IsGlobal:              &isGlobal,
+	}
+	if input.Description != "" {
+		props.Description = utils.String(input.Description)
+	}
+
+	return props
+}
+
+func expandManagerConnectivityGroupItems(input []ManagerConnectivityGroupItemModel) []connectivityconfigurations.ConnectivityGroupItem {
+	results := make([]connectivityconfigurations.ConnectivityGroupItem, 0)
+	for _, v := range input {
+		isGlobal := connectivityconfigurations.IsGlobalFalse
+		if v.GlobalMeshEnabled {
+			isGlobal = connectivityconfigurations.IsGlobalTrue
+		}
+
+		useHubGateway := connectivityconfigurations.UseHubGatewayFalse
+		if v.UseHubGateway {
+			useHubGateway = connectivityconfigurations.UseHubGatewayTrue
+		}
+
+		results = append(results, connectivityconfigurations.ConnectivityGroupItem{
+			GroupConnectivity: connectivityconfigurations.GroupConnectivity(v.GroupConnectivity),
+			IsGlobal:          &isGlobal,
+			NetworkGroupId:    v.NetworkGroupId,
+			UseHubGateway:     &useHubGateway,
+		})
+	}
+	return results
+}
+
+func flattenManagerConnectivityGroupItems(input []connectivityconfigurations.ConnectivityGroupItem) []ManagerConnectivityGroupItemModel {
+	results := make([]ManagerConnectivityGroupItemModel, 0)
+	for _, v := range input {
+		item := ManagerConnectivityGroupItemModel{
+			NetworkGroupId:    v.NetworkGroupId,
+			GroupConnectivity: string(v.GroupConnectivity),
+		}
+		if v.IsGlobal != nil {
+			item.GlobalMeshEnabled = *v.IsGlobal == connectivityconfigurations.IsGlobalTrue
+		}
+		if v.UseHubGateway != nil {
+			item.UseHubGateway = *v.UseHubGateway == connectivityconfigurations.UseHubGatewayTrue
+		}
+		results = append(results, item)
+	}
+	return results
+}
+
+func expandManagerHubs(input []ManagerHubModel) *[]connectivityconfigurations.Hub {
+	results := make([]connectivityconfigurations.Hub, 0)
+	for _, v := range input {
+		results = append(results, connectivityconfigurations.Hub{
+			ResourceId:   utils.String(v.ResourceId),
+			ResourceType: utils.String(v.ResourceType),
+		})
+	}
+	return &results
+}
+
+func flattenManagerHubs(input *[]connectivityconfigurations.Hub) []ManagerHubModel {
+	results := make([]ManagerHubModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		hub := ManagerHubModel{}
+		if v.ResourceId != nil {
+			hub.ResourceId = *v.ResourceId
+		}
+		if v.ResourceType != nil {
+			hub.ResourceType = *v.ResourceType
+		}
+		results = append(results, hub)
+	}
+	return results
+}
@@ -0,0 +1,309 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerDeploymentResource{}
+
+type ManagerDeploymentResource struct{}
+
+type ManagerDeploymentModel struct {
+	NetworkManagerId string   `tfschema:"network_manager_id"`
+	Location         string   `tfschema:"location"`
+	ScopeAccess      string   `tfschema:"scope_access"`
+	ConfigurationIds []string `tfschema:"configuration_ids"`
+}
+
+func (r ManagerDeploymentResource) ResourceType() string {
+	return "azurerm_network_manager_deployment"
+}
+
+func (r ManagerDeploymentResource) ModelObject() interface{} {
+	return &ManagerDeploymentModel{}
+}
+
+func (r ManagerDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerDeploymentID
+}
+
+func (r ManagerDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"location": commonschema.Location(),
+
+		"scope_access": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForConfigurationType(), false),
+		},
+
+		"configuration_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			var state ManagerDeploymentModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(state.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, location.Normalize(state.Location), state.ScopeAccess)
+
+			existing, err := getManagerDeploymentStatus(ctx, client, id)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if existing != nil && existing.ConfigurationIds != nil && len(*existing.ConfigurationIds) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := commitManagerDeployment(ctx, client, id, state.ConfigurationIds); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			deployment, err := getManagerDeploymentStatus(ctx, client, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			// committing an empty set of configurations is how a Deployment is removed - and the Deployment is also
+			// gone when the Network Manager has been deleted
+			if deployment == nil || deployment.ConfigurationIds == nil || len(*deployment.ConfigurationIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ManagerDeploymentModel{
+				NetworkManagerId: id.NetworkManagerId().ID(),
+				Location:         location.Normalize(id.Location),
+				ScopeAccess:      id.ScopeAccess,
+				ConfigurationIds: *deployment.ConfigurationIds,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ManagerDeploymentModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := commitManagerDeployment(ctx, client, *id, state.ConfigurationIds); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := commitManagerDeployment(ctx, client, *id, []string{}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func commitManagerDeployment(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId, configurationIds []string) error {
+	input := networkmanagers.NetworkManagerCommit{
+		CommitType:       networkmanagers.ConfigurationType(id.ScopeAccess),
+		ConfigurationIds: &configurationIds,
+		TargetLocations:  []string{id.Location},
+	}
+
+	if err := client.CommitThenPoll(ctx, id.NetworkManagerId(), input); err != nil {
+		return fmt.Errorf("committing: %+v", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	// the Commit completes before the configurations have been rolled out to (or removed from) the region
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(networkmanagers.DeploymentStatusNotStarted),
+			string(networkmanagers.DeploymentStatusDeploying),
+		},
+		Target:     []string{string(networkmanagers.DeploymentStatusDeployed)},
+		Refresh:    managerDeploymentStateRefreshFunc(ctx, client, id),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if len(configurationIds) == 0 {
+		stateConf.Pending = append(stateConf.Pending, string(networkmanagers.DeploymentStatusDeployed))
+		stateConf.Target = []string{managerDeploymentStatusRemoved}
+		stateConf.Refresh = managerDeploymentRemovedStateRefreshFunc(ctx, client, id)
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Deployment to complete: %+v", err)
+	}
+
+	return nil
+}
+
+// managerDeploymentStatusRemoved is a pseudo-status used once a Deployment no longer contains any configurations
+const managerDeploymentStatusRemoved = "Removed"
+
+func managerDeploymentRemovedStateRefreshFunc(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deployment, err := getManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		// once removed the status entry is either omitted or contains no configurations
+		if deployment == nil || deployment.ConfigurationIds == nil || len(*deployment.ConfigurationIds) == 0 {
+			return id, managerDeploymentStatusRemoved, nil
+		}
+
+		return managerDeploymentStatusFor(deployment)
+	}
+}
+
+func managerDeploymentStateRefreshFunc(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deployment, err := getManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		return managerDeploymentStatusFor(deployment)
+	}
+}
+
+func managerDeploymentStatusFor(deployment *networkmanagers.NetworkManagerDeploymentStatus) (interface{}, string, error) {
+	if deployment == nil || deployment.DeploymentStatus == nil {
+		return deployment, string(networkmanagers.DeploymentStatusNotStarted), nil
+	}
+
+	if *deployment.DeploymentStatus == networkmanagers.DeploymentStatusFailed {
+		message := ""
+		if deployment.ErrorMessage != nil {
+			message = *deployment.ErrorMessage
+		}
+		return deployment, string(*deployment.DeploymentStatus), fmt.Errorf("the Deployment failed: %s", message)
+	}
+
+	return deployment, string(*deployment.DeploymentStatus), nil
+}
+
+func getManagerDeploymentStatus(ctx context.Context, client *networkmanagers.NetworkManagersClient, id parse.NetworkManagerDeploymentId) (*networkmanagers.NetworkManagerDeploymentStatus, error) {
+	input := networkmanagers.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanagers.ConfigurationType{networkmanagers.ConfigurationType(id.ScopeAccess)},
+		Regions:         &[]string{id.Location},
+	}
+
+	resp, err := client.ListDeploymentStatus(ctx, id.NetworkManagerId(), input)
+	if err != nil {
+		// the Deployment can't exist without the Network Manager, so is treated as removed
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil, nil
+	}
+
+	for _, v := range *resp.Model.Value {
+		if v.Region == nil || location.Normalize(*v.Region) != location.Normalize(id.Location) {
+			continue
+		}
+		if v.DeploymentType == nil || string(*v.DeploymentType) != id.ScopeAccess {
+			continue
+		}
+
+		deployment := v
+		return &deployment, nil
+	}
+
+	return nil, nil
+}
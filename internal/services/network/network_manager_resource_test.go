@@ -0,0 +1,229 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerResource struct{}
+
+// Network Managers which share a Scope conflict with one another, so these tests are run in sequence
+func TestAccNetworkManager(t *testing.T) {
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"Manager": {
+			"basic":          testAccNetworkManager_basic,
+			"requiresImport": testAccNetworkManager_requiresImport,
+			"complete":       testAccNetworkManager_complete,
+			"update":         testAccNetworkManager_update,
+		},
+		"NetworkGroup": {
+			"basic":          testAccNetworkManagerNetworkGroup_basic,
+			"requiresImport": testAccNetworkManagerNetworkGroup_requiresImport,
+			"update":         testAccNetworkManagerNetworkGroup_update,
+		},
+		"StaticMember": {
+			"basic":          testAccNetworkManagerStaticMember_basic,
+			"requiresImport": testAccNetworkManagerStaticMember_requiresImport,
+		},
+		"ConnectivityConfiguration": {
+			"basic":          testAccNetworkManagerConnectivityConfiguration_basic,
+			"requiresImport": testAccNetworkManagerConnectivityConfiguration_requiresImport,
+			"complete":       testAccNetworkManagerConnectivityConfiguration_complete,
+			"update":         testAccNetworkManagerConnectivityConfiguration_update,
+		},
+		"SecurityAdminConfiguration": {
+			"basic":          testAccNetworkManagerSecurityAdminConfiguration_basic,
+			"requiresImport": testAccNetworkManagerSecurityAdminConfiguration_requiresImport,
+			"update":         testAccNetworkManagerSecurityAdminConfiguration_update,
+		},
+		"AdminRuleCollection": {
+			"basic":          testAccNetworkManagerAdminRuleCollection_basic,
+			"requiresImport": testAccNetworkManagerAdminRuleCollection_requiresImport,
+			"update":         testAccNetworkManagerAdminRuleCollection_update,
+		},
+		"AdminRule": {
+			"basic":          testAccNetworkManagerAdminRule_basic,
+			"requiresImport": testAccNetworkManagerAdminRule_requiresImport,
+			"complete":       testAccNetworkManagerAdminRule_complete,
+			"update":         testAccNetworkManagerAdminRule_update,
+		},
+		"Deployment": {
+			"basic":          testAccNetworkManagerDeployment_basic,
+			"requiresImport": testAccNetworkManagerDeployment_requiresImport,
+			"securityAdmin":  testAccNetworkManagerDeployment_securityAdmin,
+			"update":         testAccNetworkManagerDeployment_update,
+		},
+	})
+}
+
+func testAccNetworkManager_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := ManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManager_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := ManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccNetworkManager_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := ManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManager_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := ManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkmanagers.ParseNetworkManagerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.ManagersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-network-manager-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ManagerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  scope_accesses      = ["SecurityAdmin"]
+
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager" "import" {
+  name                = azurerm_network_manager.test.name
+  location            = azurerm_network_manager.test.location
+  resource_group_name = azurerm_network_manager.test.resource_group_name
+  scope_accesses      = azurerm_network_manager.test.scope_accesses
+
+  scope {
+    subscription_ids = azurerm_network_manager.test.scope.0.subscription_ids
+  }
+}
+`, r.basic(data))
+}
+
+func (r ManagerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  scope_accesses      = ["Connectivity", "SecurityAdmin"]
+  description         = "test network manager"
+
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
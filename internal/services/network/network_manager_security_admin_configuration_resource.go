@@ -0,0 +1,242 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/securityadminconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerSecurityAdminConfigurationResource{}
+
+type ManagerSecurityAdminConfigurationResource struct{}
+
+type ManagerSecurityAdminConfigurationModel struct {
+	Name                                    string   `tfschema:"name"`
+	NetworkManagerId                        string   `tfschema:"network_manager_id"`
+	ApplyOnNetworkIntentPolicyBasedServices []string `tfschema:"apply_on_network_intent_policy_based_services"`
+	Description                             string   `tfschema:"description"`
+}
+
+func (r ManagerSecurityAdminConfigurationResource) ResourceType() string {
+	return "azurerm_network_manager_security_admin_configuration"
+}
+
+func (r ManagerSecurityAdminConfigurationResource) ModelObject() interface{} {
+	return &ManagerSecurityAdminConfigurationModel{}
+}
+
+func (r ManagerSecurityAdminConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return securityadminconfigurations.ValidateSecurityAdminConfigurationID
+}
+
+func (r ManagerSecurityAdminConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: securityadminconfigurations.ValidateNetworkManagerID,
+		},
+
+		"apply_on_network_intent_policy_based_services": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(securityadminconfigurations.PossibleValuesForNetworkIntentPolicyBasedService(), false),
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagerSecurityAdminConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerSecurityAdminConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerSecurityAdminConfigurationsClient
+
+			var state ManagerSecurityAdminConfigurationModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId, err := securityadminconfigurations.ParseNetworkManagerID(state.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := securityadminconfigurations.NewSecurityAdminConfigurationID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, state.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := securityadminconfigurations.SecurityAdminConfiguration{
+				Properties: &securityadminconfigurations.SecurityAdminConfigurationPropertiesFormat{
+					ApplyOnNetworkIntentPolicyBasedServices: expandManagerNetworkIntentPolicyBasedServices(state.ApplyOnNetworkIntentPolicyBasedServices),
+				},
+			}
+			if state.Description != "" {
+				input.Properties.Description = utils.String(state.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerSecurityAdminConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerSecurityAdminConfigurationsClient
+
+			id, err := securityadminconfigurations.ParseSecurityAdminConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerSecurityAdminConfigurationModel{
+				Name:             id.SecurityAdminConfigurationName,
+				NetworkManagerId: securityadminconfigurations.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ApplyOnNetworkIntentPolicyBasedServices = flattenManagerNetworkIntentPolicyBasedServices(props.ApplyOnNetworkIntentPolicyBasedServices)
+					if props.Description != nil {
+						state.Description = *props.Description
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerSecurityAdminConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerSecurityAdminConfigurationsClient
+
+			id, err := securityadminconfigurations.ParseSecurityAdminConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ManagerSecurityAdminConfigurationModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			model := *existing.Model
+			if metadata.ResourceData.HasChange("apply_on_network_intent_policy_based_services") {
+				model.Properties.ApplyOnNetworkIntentPolicyBasedServices = expandManagerNetworkIntentPolicyBasedServices(state.ApplyOnNetworkIntentPolicyBasedServices)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				model.Properties.Description = nil
+				if state.Description != "" {
+					model.Properties.Description = utils.String(state.Description)
+				}
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerSecurityAdminConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerSecurityAdminConfigurationsClient
+
+			id, err := securityadminconfigurations.ParseSecurityAdminConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id, securityadminconfigurations.DeleteOperationOptions{Force: utils.Bool(true)}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandManagerNetworkIntentPolicyBasedServices(input []string) *[]securityadminconfigurations.NetworkIntentPolicyBasedService {
+	results := make([]securityadminconfigurations.NetworkIntentPolicyBasedService, 0)
+	for _, v := range input {
+		results = append(results, securityadminconfigurations.NetworkIntentPolicyBasedService(v))
+	}
+	return &results
+}
+
+func flattenManagerNetworkIntentPolicyBasedServices(input *[]securityadminconfigurations.NetworkIntentPolicyBasedService) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, string(v))
+	}
+	return results
+}
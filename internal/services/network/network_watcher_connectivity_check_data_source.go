@@ -0,0 +1,301 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkWatcherConnectivityCheck() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherConnectivityCheckRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkWatcherID,
+			},
+
+			"source_virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: computeValidate.VirtualMachineID,
+			},
+
+			"source_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"destination_virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: computeValidate.VirtualMachineID,
+				ExactlyOneOf: []string{"destination_virtual_machine_id", "destination_address"},
+			},
+
+			"destination_address": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"destination_virtual_machine_id", "destination_address"},
+			},
+
+			"destination_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(network.ProtocolTCP),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolTCP),
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+					string(network.ProtocolIcmp),
+				}, false),
+			},
+
+			"preferred_ip_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPVersionIPv4),
+					string(network.IPVersionIPv6),
+				}, false),
+			},
+
+			"connection_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"avg_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"min_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"max_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_sent": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_failed": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"hop": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"issue": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"origin": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"severity": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherConnectivityCheckRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.ConnectivityParameters{
+		Source: &network.ConnectivitySource{
+			ResourceID: utils.String(d.Get("source_virtual_machine_id").(string)),
+		},
+		Destination: &network.ConnectivityDestination{},
+		Protocol:    network.Protocol(d.Get("protocol").(string)),
+	}
+
+	if v, ok := d.GetOk("source_port"); ok {
+		parameters.Source.Port = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("destination_virtual_machine_id"); ok {
+		parameters.Destination.ResourceID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("destination_address"); ok {
+		parameters.Destination.Address = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("destination_port"); ok {
+		parameters.Destination.Port = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("preferred_ip_version"); ok {
+		parameters.PreferredIPVersion = network.IPVersion(v.(string))
+	}
+
+	future, err := client.CheckConnectivity(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("checking connectivity with %s: %+v", *watcherId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the connectivity check with %s: %+v", *watcherId, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the connectivity check result from %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("connection_status", string(result.ConnectionStatus))
+	d.Set("avg_latency_in_ms", result.AvgLatencyInMs)
+	d.Set("min_latency_in_ms", result.MinLatencyInMs)
+	d.Set("max_latency_in_ms", result.MaxLatencyInMs)
+	d.Set("probes_sent", result.ProbesSent)
+	d.Set("probes_failed", result.ProbesFailed)
+
+	if err := d.Set("hop", flattenNetworkWatcherConnectivityHops(result.Hops)); err != nil {
+		return fmt.Errorf("setting `hop`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkWatcherConnectivityHops(input *[]network.ConnectivityHop) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var id string
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		var hopType string
+		if item.Type != nil {
+			hopType = *item.Type
+		}
+
+		var address string
+		if item.Address != nil {
+			address = *item.Address
+		}
+
+		var resourceId string
+		if item.ResourceID != nil {
+			resourceId = *item.ResourceID
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":           id,
+			"type":         hopType,
+			"address":      address,
+			"resource_id":  resourceId,
+			"next_hop_ids": utils.FlattenStringSlice(item.NextHopIds),
+			"issue":        flattenNetworkWatcherConnectivityIssues(item.Issues),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkWatcherConnectivityIssues(input *[]network.ConnectivityIssue) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"origin":   string(item.Origin),
+			"severity": string(item.Severity),
+			"type":     string(item.Type),
+		})
+	}
+
+	return results
+}
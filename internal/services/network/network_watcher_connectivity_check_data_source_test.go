@@ -0,0 +1,186 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherConnectivityCheckDataSource struct{}
+
+func testAccDataSourceNetworkWatcherConnectivityCheck_virtualMachine(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_connectivity_check", "test")
+	r := NetworkWatcherConnectivityCheckDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.virtualMachine(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("connection_status").HasValue("Reachable"),
+				check.That(data.ResourceName).Key("probes_sent").Exists(),
+				check.That(data.ResourceName).Key("hop.#").Exists(),
+			),
+		},
+	})
+}
+
+func testAccDataSourceNetworkWatcherConnectivityCheck_address(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_connectivity_check", "test")
+	r := NetworkWatcherConnectivityCheckDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.address(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("connection_status").Exists(),
+				check.That(data.ResourceName).Key("hop.#").Exists(),
+			),
+		},
+	})
+}
+
+func (r NetworkWatcherConnectivityCheckDataSource) virtualMachine(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_connectivity_check" "test" {
+  network_watcher_id             = azurerm_network_watcher.test.id
+  source_virtual_machine_id      = azurerm_linux_virtual_machine.src.id
+  destination_virtual_machine_id = azurerm_linux_virtual_machine.dest.id
+  destination_port               = 22
+
+  depends_on = [azurerm_virtual_machine_extension.src]
+}
+`, r.template(data))
+}
+
+func (r NetworkWatcherConnectivityCheckDataSource) address(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_connectivity_check" "test" {
+  network_watcher_id        = azurerm_network_watcher.test.id
+  source_virtual_machine_id = azurerm_linux_virtual_machine.src.id
+  destination_address       = "www.bing.com"
+  destination_port          = 443
+  protocol                  = "Https"
+  preferred_ip_version      = "IPv4"
+
+  depends_on = [azurerm_virtual_machine_extension.src]
+}
+`, r.template(data))
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-watcher-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_watcher" "test" {
+  name                = "acctest-watcher-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "src" {
+  name                = "acctest-srcnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "src" {
+  name                            = "acctest-srcvm-%[1]d"
+  location                        = azurerm_resource_group.test.location
+  resource_group_name             = azurerm_resource_group.test.name
+  network_interface_ids           = [azurerm_network_interface.src.id]
+  size                            = "Standard_F2"
+  admin_username                  = "testadmin"
+  admin_password                  = "Password1234!"
+  disable_password_authentication = false
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_virtual_machine_extension" "src" {
+  name                       = "acctest-vmextension"
+  virtual_machine_id         = azurerm_linux_virtual_machine.src.id
+  publisher                  = "Microsoft.Azure.NetworkWatcher"
+  type                       = "NetworkWatcherAgentLinux"
+  type_handler_version       = "1.4"
+  auto_upgrade_minor_version = true
+}
+
+resource "azurerm_network_interface" "dest" {
+  name                = "acctest-destnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "dest" {
+  name                            = "acctest-destvm-%[1]d"
+  location                        = azurerm_resource_group.test.location
+  resource_group_name             = azurerm_resource_group.test.name
+  network_interface_ids           = [azurerm_network_interface.dest.id]
+  size                            = "Standard_F2"
+  admin_username                  = "testadmin"
+  admin_password                  = "Password1234!"
+  disable_password_authentication = false
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
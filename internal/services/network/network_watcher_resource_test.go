@@ -33,7 +33,11 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"disappears":     testAccNetworkWatcher_disappears,
 		},
 		"DataSource": {
-			"basic": testAccDataSourceNetworkWatcher_basic,
+			"basic":                    testAccDataSourceNetworkWatcher_basic,
+			"connectivityCheckVM":      testAccDataSourceNetworkWatcherConnectivityCheck_virtualMachine,
+			"connectivityCheckAddress": testAccDataSourceNetworkWatcherConnectivityCheck_address,
+			"nextHop":                  testAccDataSourceNetworkWatcherNextHop_basic,
+			"ipFlowVerify":             testAccDataSourceNetworkWatcherIPFlowVerify_basic,
 		},
 		"ConnectionMonitor": {
 			"addressBasic":                   testAccNetworkConnectionMonitor_addressBasic,
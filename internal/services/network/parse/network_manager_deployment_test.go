@@ -0,0 +1,96 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestNetworkManagerDeploymentIDFormatter(t *testing.T) {
+	actual := NewNetworkManagerDeploymentID("12345678-1234-9876-4563-123456789012", "resGroup1", "manager1", "eastus", "Connectivity").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit|eastus|Connectivity"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNetworkManagerDeploymentID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *NetworkManagerDeploymentId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Network Manager ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Commit Segment",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1|eastus|Connectivity",
+			Error: true,
+		},
+		{
+			Name:  "Missing Scope Access",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit|eastus",
+			Error: true,
+		},
+		{
+			Name:  "Empty Location",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit||Connectivity",
+			Error: true,
+		},
+		{
+			Name:  "Network Manager Deployment ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/commit|eastus|Connectivity",
+			Expect: &NetworkManagerDeploymentId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				NetworkManagerName: "manager1",
+				Location:           "eastus",
+				ScopeAccess:        "Connectivity",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := NetworkManagerDeploymentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expect.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expect.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != v.Expect.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expect.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.NetworkManagerName != v.Expect.NetworkManagerName {
+			t.Fatalf("Expected %q but got %q for NetworkManagerName", v.Expect.NetworkManagerName, actual.NetworkManagerName)
+		}
+
+		if actual.Location != v.Expect.Location {
+			t.Fatalf("Expected %q but got %q for Location", v.Expect.Location, actual.Location)
+		}
+
+		if actual.ScopeAccess != v.Expect.ScopeAccess {
+			t.Fatalf("Expected %q but got %q for ScopeAccess", v.Expect.ScopeAccess, actual.ScopeAccess)
+		}
+	}
+}
@@ -1,9 +1,15 @@
 package network
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+var (
+	_ sdk.TypedServiceRegistration   = Registration{}
+	_ sdk.UntypedServiceRegistration = Registration{}
+)
+
 type Registration struct{}
 
 // Name is the name of this Service
@@ -22,6 +28,7 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_application_gateway":                       dataSourceApplicationGateway(),
+		"azurerm_application_gateway_backend_health":        dataSourceApplicationGatewayBackendHealth(),
 		"azurerm_application_security_group":                dataSourceApplicationSecurityGroup(),
 		"azurerm_express_route_circuit":                     dataSourceExpressRouteCircuit(),
 		"azurerm_ip_group":                                  dataSourceIpGroup(),
@@ -30,6 +37,9 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_network_interface":                         dataSourceNetworkInterface(),
 		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
 		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
+		"azurerm_network_watcher_connectivity_check":        dataSourceNetworkWatcherConnectivityCheck(),
+		"azurerm_network_watcher_ip_flow_verify":            dataSourceNetworkWatcherIPFlowVerify(),
+		"azurerm_network_watcher_next_hop":                  dataSourceNetworkWatcherNextHop(),
 		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
 		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
 		"azurerm_private_link_service_endpoint_connections": dataSourcePrivateLinkServiceEndpointConnections(),
@@ -44,6 +54,8 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
+		"azurerm_virtual_network_available_delegations":     dataSourceVirtualNetworkAvailableDelegations(),
+		"azurerm_virtual_network_ip_availability":           dataSourceVirtualNetworkIPAvailability(),
 		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
 		"azurerm_virtual_wan":                               dataSourceVirtualWan(),
 		"azurerm_local_network_gateway":                     dataSourceLocalNetworkGateway(),
@@ -54,24 +66,31 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                      resourceApplicationGateway(),
-		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
-		"azurerm_bastion_host":                             resourceBastionHost(),
-		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
-		"azurerm_express_route_circuit_authorization":      resourceExpressRouteCircuitAuthorization(),
-		"azurerm_express_route_circuit_peering":            resourceExpressRouteCircuitPeering(),
-		"azurerm_express_route_circuit":                    resourceExpressRouteCircuit(),
-		"azurerm_express_route_connection":                 resourceExpressRouteConnection(),
-		"azurerm_express_route_gateway":                    resourceExpressRouteGateway(),
-		"azurerm_express_route_port":                       resourceArmExpressRoutePort(),
-		"azurerm_ip_group":                                 resourceIpGroup(),
-		"azurerm_local_network_gateway":                    resourceLocalNetworkGateway(),
-		"azurerm_nat_gateway":                              resourceNatGateway(),
-		"azurerm_nat_gateway_public_ip_association":        resourceNATGatewayPublicIpAssociation(),
-		"azurerm_nat_gateway_public_ip_prefix_association": resourceNATGatewayPublicIpPrefixAssociation(),
-		"azurerm_network_connection_monitor":               resourceNetworkConnectionMonitor(),
-		"azurerm_network_ddos_protection_plan":             resourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                        resourceNetworkInterface(),
+		"azurerm_application_gateway":                       resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool":  resourceApplicationGatewayBackendAddressPool(),
+		"azurerm_application_gateway_backend_http_settings": resourceApplicationGatewayBackendHTTPSettings(),
+		"azurerm_application_gateway_http_listener":         resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_gateway_probe":                 resourceApplicationGatewayProbe(),
+		"azurerm_application_gateway_request_routing_rule":  resourceApplicationGatewayRequestRoutingRule(),
+		"azurerm_application_gateway_ssl_certificate":       resourceApplicationGatewaySslCertificate(),
+		"azurerm_application_security_group":                resourceApplicationSecurityGroup(),
+		"azurerm_bastion_host":                              resourceBastionHost(),
+		"azurerm_express_route_circuit_connection":          resourceExpressRouteCircuitConnection(),
+		"azurerm_express_route_circuit_authorization":       resourceExpressRouteCircuitAuthorization(),
+		"azurerm_express_route_circuit_peering":             resourceExpressRouteCircuitPeering(),
+		"azurerm_express_route_circuit":                     resourceExpressRouteCircuit(),
+		"azurerm_express_route_connection":                  resourceExpressRouteConnection(),
+		"azurerm_express_route_gateway":                     resourceExpressRouteGateway(),
+		"azurerm_express_route_port":                        resourceArmExpressRoutePort(),
+		"azurerm_express_route_port_authorization":          resourceExpressRoutePortAuthorization(),
+		"azurerm_ip_group":                                  resourceIpGroup(),
+		"azurerm_local_network_gateway":                     resourceLocalNetworkGateway(),
+		"azurerm_nat_gateway":                               resourceNatGateway(),
+		"azurerm_nat_gateway_public_ip_association":         resourceNATGatewayPublicIpAssociation(),
+		"azurerm_nat_gateway_public_ip_prefix_association":  resourceNATGatewayPublicIpPrefixAssociation(),
+		"azurerm_network_connection_monitor":                resourceNetworkConnectionMonitor(),
+		"azurerm_network_ddos_protection_plan":              resourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_interface":                         resourceNetworkInterface(),
 
 		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 		"azurerm_network_interface_application_security_group_association":               resourceNetworkInterfaceApplicationSecurityGroupAssociation(),
@@ -108,6 +127,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                   resourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_nat_rule":          resourceVirtualNetworkGatewayNatRule(),
 		"azurerm_virtual_network_peering":                   resourceVirtualNetworkPeering(),
 		"azurerm_virtual_network":                           resourceVirtualNetwork(),
 		"azurerm_virtual_wan":                               resourceVirtualWan(),
@@ -119,3 +139,23 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
 	}
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ManagerResource{},
+		ManagerAdminRuleCollectionResource{},
+		ManagerAdminRuleResource{},
+		ManagerConnectivityConfigurationResource{},
+		ManagerDeploymentResource{},
+		ManagerNetworkGroupResource{},
+		ManagerSecurityAdminConfigurationResource{},
+		ManagerStaticMemberResource{},
+		RouteMapResource{},
+	}
+}
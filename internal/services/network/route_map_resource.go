@@ -0,0 +1,471 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = RouteMapResource{}
+
+type RouteMapResource struct{}
+
+type RouteMapModel struct {
+	Name         string              `tfschema:"name"`
+	VirtualHubId string              `tfschema:"virtual_hub_id"`
+	Rules        []RouteMapRuleModel `tfschema:"rule"`
+}
+
+type RouteMapRuleModel struct {
+	Name              string                   `tfschema:"name"`
+	Actions           []RouteMapActionModel    `tfschema:"action"`
+	MatchCriteria     []RouteMapCriterionModel `tfschema:"match_criterion"`
+	NextStepIfMatched string                   `tfschema:"next_step_if_matched"`
+}
+
+type RouteMapActionModel struct {
+	Type       string                   `tfschema:"type"`
+	Parameters []RouteMapParameterModel `tfschema:"parameter"`
+}
+
+type RouteMapParameterModel struct {
+	AsPath      []string `tfschema:"as_path"`
+	Community   []string `tfschema:"community"`
+	RoutePrefix []string `tfschema:"route_prefix"`
+}
+
+type RouteMapCriterionModel struct {
+	MatchCondition string   `tfschema:"match_condition"`
+	AsPath         []string `tfschema:"as_path"`
+	Community      []string `tfschema:"community"`
+	RoutePrefix    []string `tfschema:"route_prefix"`
+}
+
+func (r RouteMapResource) ResourceType() string {
+	return "azurerm_route_map"
+}
+
+func (r RouteMapResource) ModelObject() interface{} {
+	return &RouteMapModel{}
+}
+
+func (r RouteMapResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return routemaps.ValidateRouteMapID
+}
+
+func (r RouteMapResource) Arguments() map[string]*pluginsdk.Schema {
+	stringList := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"virtual_hub_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: routemaps.ValidateVirtualHubID,
+		},
+
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"action": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(routemaps.RouteMapActionTypeAdd),
+										string(routemaps.RouteMapActionTypeDrop),
+										string(routemaps.RouteMapActionTypeRemove),
+										string(routemaps.RouteMapActionTypeReplace),
+									}, false),
+								},
+
+								"parameter": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"as_path": stringList(),
+
+											"community": stringList(),
+
+											"route_prefix": stringList(),
+										},
+									},
+								},
+							},
+						},
+					},
+
+					"match_criterion": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"match_condition": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(routemaps.RouteMapMatchConditionContains),
+										string(routemaps.RouteMapMatchConditionEquals),
+										string(routemaps.RouteMapMatchConditionNotContains),
+										string(routemaps.RouteMapMatchConditionNotEquals),
+									}, false),
+								},
+
+								"as_path": stringList(),
+
+								"community": stringList(),
+
+								"route_prefix": stringList(),
+							},
+						},
+					},
+
+					"next_step_if_matched": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(routemaps.NextStepUnknown),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(routemaps.NextStepContinue),
+							string(routemaps.NextStepTerminate),
+							string(routemaps.NextStepUnknown),
+						}, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r RouteMapResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r RouteMapResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.RouteMapsClient
+
+			var state RouteMapModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			virtualHubId, err := routemaps.ParseVirtualHubID(state.VirtualHubId)
+			if err != nil {
+				return err
+			}
+
+			id := routemaps.NewRouteMapID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroupName, virtualHubId.VirtualHubName, state.Name)
+
+			locks.ByName(id.VirtualHubName, virtualHubResourceName)
+			defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := routemaps.RouteMap{
+				Properties: &routemaps.RouteMapProperties{
+					Rules: expandRouteMapRules(state.Rules),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r RouteMapResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.RouteMapsClient
+
+			id, err := routemaps.ParseRouteMapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := RouteMapModel{
+				Name:         id.RouteMapName,
+				VirtualHubId: routemaps.NewVirtualHubID(id.SubscriptionId, id.ResourceGroupName, id.VirtualHubName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Rules = flattenRouteMapRules(props.Rules)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RouteMapResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.RouteMapsClient
+
+			id, err := routemaps.ParseRouteMapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state RouteMapModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.VirtualHubName, virtualHubResourceName)
+			defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			model := *existing.Model
+			if metadata.ResourceData.HasChange("rule") {
+				model.Properties.Rules = expandRouteMapRules(state.Rules)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r RouteMapResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.RouteMapsClient
+
+			id, err := routemaps.ParseRouteMapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualHubName, virtualHubResourceName)
+			defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandRouteMapRules(input []RouteMapRuleModel) *[]routemaps.RouteMapRule {
+	results := make([]routemaps.RouteMapRule, 0)
+
+	for _, v := range input {
+		nextStep := routemaps.NextStep(v.NextStepIfMatched)
+		rule := routemaps.RouteMapRule{
+			Name:              utils.String(v.Name),
+			Actions:           expandRouteMapActions(v.Actions),
+			MatchCriteria:     expandRouteMapCriteria(v.MatchCriteria),
+			NextStepIfMatched: &nextStep,
+		}
+
+		results = append(results, rule)
+	}
+
+	return &results
+}
+
+func expandRouteMapActions(input []RouteMapActionModel) *[]routemaps.Action {
+	results := make([]routemaps.Action, 0)
+
+	for _, v := range input {
+		actionType := routemaps.RouteMapActionType(v.Type)
+		parameters := make([]routemaps.Parameter, 0)
+		for _, p := range v.Parameters {
+			parameters = append(parameters, routemaps.Parameter{
+				AsPath:      expandRouteMapStringSlice(p.AsPath),
+				Community:   expandRouteMapStringSlice(p.Community),
+				RoutePrefix: expandRouteMapStringSlice(p.RoutePrefix),
+			})
+		}
+
+		results = append(results, routemaps.Action{
+			Type:       &actionType,
+			Parameters: &parameters,
+		})
+	}
+
+	return &results
+}
+
+func expandRouteMapCriteria(input []RouteMapCriterionModel) *[]routemaps.Criterion {
+	results := make([]routemaps.Criterion, 0)
+
+	for _, v := range input {
+		matchCondition := routemaps.RouteMapMatchCondition(v.MatchCondition)
+		results = append(results, routemaps.Criterion{
+			MatchCondition: &matchCondition,
+			AsPath:         expandRouteMapStringSlice(v.AsPath),
+			Community:      expandRouteMapStringSlice(v.Community),
+			RoutePrefix:    expandRouteMapStringSlice(v.RoutePrefix),
+		})
+	}
+
+	return &results
+}
+
+func flattenRouteMapRules(input *[]routemaps.RouteMapRule) []RouteMapRuleModel {
+	results := make([]RouteMapRuleModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		rule := RouteMapRuleModel{
+			Actions:       flattenRouteMapActions(v.Actions),
+			MatchCriteria: flattenRouteMapCriteria(v.MatchCriteria),
+		}
+
+		if v.Name != nil {
+			rule.Name = *v.Name
+		}
+
+		if v.NextStepIfMatched != nil {
+			rule.NextStepIfMatched = string(*v.NextStepIfMatched)
+		}
+
+		results = append(results, rule)
+	}
+
+	return results
+}
+
+func flattenRouteMapActions(input *[]routemaps.Action) []RouteMapActionModel {
+	results := make([]RouteMapActionModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		action := RouteMapActionModel{
+			Parameters: make([]RouteMapParameterModel, 0),
+		}
+
+		if v.Type != nil {
+			action.Type = string(*v.Type)
+		}
+
+		if v.Parameters != nil {
+			for _, p := range *v.Parameters {
+				action.Parameters = append(action.Parameters, RouteMapParameterModel{
+					AsPath:      flattenRouteMapStringSlice(p.AsPath),
+					Community:   flattenRouteMapStringSlice(p.Community),
+					RoutePrefix: flattenRouteMapStringSlice(p.RoutePrefix),
+				})
+			}
+		}
+
+		results = append(results, action)
+	}
+
+	return results
+}
+
+func flattenRouteMapCriteria(input *[]routemaps.Criterion) []RouteMapCriterionModel {
+	results := make([]RouteMapCriterionModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		criterion := RouteMapCriterionModel{
+			AsPath:      flattenRouteMapStringSlice(v.AsPath),
+			Community:   flattenRouteMapStringSlice(v.Community),
+			RoutePrefix: flattenRouteMapStringSlice(v.RoutePrefix),
+		}
+
+		if v.MatchCondition != nil {
+			criterion.MatchCondition = string(*v.MatchCondition)
+		}
+
+		results = append(results, criterion)
+	}
+
+	return results
+}
+
+func expandRouteMapStringSlice(input []string) *[]string {
+	return &input
+}
+
+func flattenRouteMapStringSlice(input *[]string) []string {
+	if input == nil {
+		return make([]string, 0)
+	}
+	return *input
+}
@@ -0,0 +1,7 @@
+package adminrulecollections
+
+type AdminRuleCollectionPropertiesFormat struct {
+	AppliesToGroups   []NetworkManagerSecurityGroupItem `json:"appliesToGroups"`
+	Description       *string                           `json:"description,omitempty"`
+	ProvisioningState *ProvisioningState                `json:"provisioningState,omitempty"`
+}
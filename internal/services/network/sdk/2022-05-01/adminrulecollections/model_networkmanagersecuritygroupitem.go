@@ -0,0 +1,5 @@
+package adminrulecollections
+
+type NetworkManagerSecurityGroupItem struct {
+	NetworkGroupId string `json:"networkGroupId"`
+}
@@ -0,0 +1,9 @@
+package adminrulecollections
+
+import "fmt"
+
+const defaultApiVersion = "2022-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/adminrulecollections/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package expressroutecircuits
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ExpressRouteCircuitsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewExpressRouteCircuitsClientWithBaseURI(endpoint string) ExpressRouteCircuitsClient {
+	return ExpressRouteCircuitsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,124 @@
+package expressroutecircuits
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ExpressRouteCircuitId{}
+
+// ExpressRouteCircuitId is a struct representing the Resource ID for a Express Route Circuit
+type ExpressRouteCircuitId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	CircuitName       string
+}
+
+// NewExpressRouteCircuitID returns a new ExpressRouteCircuitId struct
+func NewExpressRouteCircuitID(subscriptionId string, resourceGroupName string, circuitName string) ExpressRouteCircuitId {
+	return ExpressRouteCircuitId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		CircuitName:       circuitName,
+	}
+}
+
+// ParseExpressRouteCircuitID parses 'input' into a ExpressRouteCircuitId
+func ParseExpressRouteCircuitID(input string) (*ExpressRouteCircuitId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ExpressRouteCircuitId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ExpressRouteCircuitId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CircuitName, ok = parsed.Parsed["circuitName"]; !ok {
+		return nil, fmt.Errorf("the segment 'circuitName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseExpressRouteCircuitIDInsensitively parses 'input' case-insensitively into a ExpressRouteCircuitId
+// note: this method should only be used for API response data and not user input
+func ParseExpressRouteCircuitIDInsensitively(input string) (*ExpressRouteCircuitId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ExpressRouteCircuitId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ExpressRouteCircuitId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CircuitName, ok = parsed.Parsed["circuitName"]; !ok {
+		return nil, fmt.Errorf("the segment 'circuitName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateExpressRouteCircuitID checks that 'input' can be parsed as a Express Route Circuit ID
+func ValidateExpressRouteCircuitID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseExpressRouteCircuitID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Express Route Circuit ID
+func (id ExpressRouteCircuitId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.CircuitName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Express Route Circuit ID
+func (id ExpressRouteCircuitId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("subscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("resourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("providers", "providers", "providers"),
+		resourceids.ResourceProviderSegment("microsoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("expressRouteCircuits", "expressRouteCircuits", "expressRouteCircuits"),
+		resourceids.UserSpecifiedSegment("circuitName", "circuitValue"),
+	}
+}
+
+// String returns a human-readable description of this Express Route Circuit ID
+func (id ExpressRouteCircuitId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Circuit Name: %q", id.CircuitName),
+	}
+	return fmt.Sprintf("Express Route Circuit (%s)", strings.Join(components, "\n"))
+}
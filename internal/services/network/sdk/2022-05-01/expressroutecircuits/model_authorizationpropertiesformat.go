@@ -0,0 +1,7 @@
+package expressroutecircuits
+
+type AuthorizationPropertiesFormat struct {
+	AuthorizationKey       *string                 `json:"authorizationKey,omitempty"`
+	AuthorizationUseStatus *AuthorizationUseStatus `json:"authorizationUseStatus,omitempty"`
+	ProvisioningState      *ProvisioningState      `json:"provisioningState,omitempty"`
+}
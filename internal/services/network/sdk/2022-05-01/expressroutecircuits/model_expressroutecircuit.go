@@ -0,0 +1,12 @@
+package expressroutecircuits
+
+type ExpressRouteCircuit struct {
+	Etag       *string                              `json:"etag,omitempty"`
+	Id         *string                              `json:"id,omitempty"`
+	Location   *string                              `json:"location,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *ExpressRouteCircuitPropertiesFormat `json:"properties,omitempty"`
+	Sku        *ExpressRouteCircuitSku              `json:"sku,omitempty"`
+	Tags       *map[string]string                   `json:"tags,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package expressroutecircuits
+
+type ExpressRouteCircuitAuthorization struct {
+	Etag       *string                        `json:"etag,omitempty"`
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *AuthorizationPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package expressroutecircuits
+
+type ExpressRouteCircuitConnection struct {
+	Etag       *string                                        `json:"etag,omitempty"`
+	Id         *string                                        `json:"id,omitempty"`
+	Name       *string                                        `json:"name,omitempty"`
+	Properties *ExpressRouteCircuitConnectionPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                                        `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package expressroutecircuits
+
+type ExpressRouteCircuitPeering struct {
+	Etag       *string                                     `json:"etag,omitempty"`
+	Id         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *ExpressRouteCircuitPeeringPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+}
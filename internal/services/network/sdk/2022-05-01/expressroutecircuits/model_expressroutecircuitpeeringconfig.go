@@ -0,0 +1,10 @@
+package expressroutecircuits
+
+type ExpressRouteCircuitPeeringConfig struct {
+	AdvertisedCommunities         *[]string                                              `json:"advertisedCommunities,omitempty"`
+	AdvertisedPublicPrefixes      *[]string                                              `json:"advertisedPublicPrefixes,omitempty"`
+	AdvertisedPublicPrefixesState *ExpressRouteCircuitPeeringAdvertisedPublicPrefixState `json:"advertisedPublicPrefixesState,omitempty"`
+	CustomerASN                   *int64                                                 `json:"customerASN,omitempty"`
+	LegacyMode                    *int64                                                 `json:"legacyMode,omitempty"`
+	RoutingRegistryName           *string                                                `json:"routingRegistryName,omitempty"`
+}
@@ -0,0 +1,7 @@
+package expressroutecircuits
+
+type ExpressRouteCircuitServiceProviderProperties struct {
+	BandwidthInMbps     *int64  `json:"bandwidthInMbps,omitempty"`
+	PeeringLocation     *string `json:"peeringLocation,omitempty"`
+	ServiceProviderName *string `json:"serviceProviderName,omitempty"`
+}
@@ -0,0 +1,7 @@
+package expressroutecircuits
+
+type ExpressRouteCircuitSku struct {
+	Family *ExpressRouteCircuitSkuFamily `json:"family,omitempty"`
+	Name   *string                       `json:"name,omitempty"`
+	Tier   *ExpressRouteCircuitSkuTier   `json:"tier,omitempty"`
+}
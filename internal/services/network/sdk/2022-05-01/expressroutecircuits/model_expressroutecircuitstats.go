@@ -0,0 +1,8 @@
+package expressroutecircuits
+
+type ExpressRouteCircuitStats struct {
+	PrimarybytesIn    *int64 `json:"primarybytesIn,omitempty"`
+	PrimarybytesOut   *int64 `json:"primarybytesOut,omitempty"`
+	SecondarybytesIn  *int64 `json:"secondarybytesIn,omitempty"`
+	SecondarybytesOut *int64 `json:"secondarybytesOut,omitempty"`
+}
@@ -0,0 +1,5 @@
+package expressroutecircuits
+
+type ExpressRouteConnectionId struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,6 @@
+package expressroutecircuits
+
+type IPv6CircuitConnectionConfig struct {
+	AddressPrefix           *string                  `json:"addressPrefix,omitempty"`
+	CircuitConnectionStatus *CircuitConnectionStatus `json:"circuitConnectionStatus,omitempty"`
+}
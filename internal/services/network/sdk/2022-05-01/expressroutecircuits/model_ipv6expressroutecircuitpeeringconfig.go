@@ -0,0 +1,9 @@
+package expressroutecircuits
+
+type IPv6ExpressRouteCircuitPeeringConfig struct {
+	MicrosoftPeeringConfig     *ExpressRouteCircuitPeeringConfig `json:"microsoftPeeringConfig,omitempty"`
+	PrimaryPeerAddressPrefix   *string                           `json:"primaryPeerAddressPrefix,omitempty"`
+	RouteFilter                *SubResource                      `json:"routeFilter,omitempty"`
+	SecondaryPeerAddressPrefix *string                           `json:"secondaryPeerAddressPrefix,omitempty"`
+	State                      *ExpressRouteCircuitPeeringState  `json:"state,omitempty"`
+}
@@ -0,0 +1,11 @@
+package expressroutecircuits
+
+type PeerExpressRouteCircuitConnectionPropertiesFormat struct {
+	AddressPrefix                  *string                  `json:"addressPrefix,omitempty"`
+	AuthResourceGuid               *string                  `json:"authResourceGuid,omitempty"`
+	CircuitConnectionStatus        *CircuitConnectionStatus `json:"circuitConnectionStatus,omitempty"`
+	ConnectionName                 *string                  `json:"connectionName,omitempty"`
+	ExpressRouteCircuitPeering     *SubResource             `json:"expressRouteCircuitPeering,omitempty"`
+	PeerExpressRouteCircuitPeering *SubResource             `json:"peerExpressRouteCircuitPeering,omitempty"`
+	ProvisioningState              *ProvisioningState       `json:"provisioningState,omitempty"`
+}
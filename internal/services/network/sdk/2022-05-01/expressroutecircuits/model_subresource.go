@@ -0,0 +1,5 @@
+package expressroutecircuits
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
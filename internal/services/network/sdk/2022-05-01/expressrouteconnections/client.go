@@ -0,0 +1,15 @@
+package expressrouteconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ExpressRouteConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewExpressRouteConnectionsClientWithBaseURI(endpoint string) ExpressRouteConnectionsClient {
+	return ExpressRouteConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
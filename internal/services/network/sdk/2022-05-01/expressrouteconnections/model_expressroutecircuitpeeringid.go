@@ -0,0 +1,5 @@
+package expressrouteconnections
+
+type ExpressRouteCircuitPeeringId struct {
+	Id *string `json:"id,omitempty"`
+}
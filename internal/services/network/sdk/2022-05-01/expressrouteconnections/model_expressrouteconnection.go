@@ -0,0 +1,7 @@
+package expressrouteconnections
+
+type ExpressRouteConnection struct {
+	Id         *string                           `json:"id,omitempty"`
+	Name       string                            `json:"name"`
+	Properties *ExpressRouteConnectionProperties `json:"properties,omitempty"`
+}
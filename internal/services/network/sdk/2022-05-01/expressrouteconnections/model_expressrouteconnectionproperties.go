@@ -0,0 +1,12 @@
+package expressrouteconnections
+
+type ExpressRouteConnectionProperties struct {
+	AuthorizationKey           *string                      `json:"authorizationKey,omitempty"`
+	EnableInternetSecurity     *bool                        `json:"enableInternetSecurity,omitempty"`
+	EnablePrivateLinkFastPath  *bool                        `json:"enablePrivateLinkFastPath,omitempty"`
+	ExpressRouteCircuitPeering ExpressRouteCircuitPeeringId `json:"expressRouteCircuitPeering"`
+	ExpressRouteGatewayBypass  *bool                        `json:"expressRouteGatewayBypass,omitempty"`
+	ProvisioningState          *ProvisioningState           `json:"provisioningState,omitempty"`
+	RoutingConfiguration       *RoutingConfiguration        `json:"routingConfiguration,omitempty"`
+	RoutingWeight              *int64                       `json:"routingWeight,omitempty"`
+}
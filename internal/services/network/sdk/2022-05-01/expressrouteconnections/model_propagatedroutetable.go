@@ -0,0 +1,6 @@
+package expressrouteconnections
+
+type PropagatedRouteTable struct {
+	Ids    *[]SubResource `json:"ids,omitempty"`
+	Labels *[]string      `json:"labels,omitempty"`
+}
@@ -0,0 +1,7 @@
+package expressrouteconnections
+
+type StaticRoute struct {
+	AddressPrefixes  *[]string `json:"addressPrefixes,omitempty"`
+	Name             *string   `json:"name,omitempty"`
+	NextHopIPAddress *string   `json:"nextHopIpAddress,omitempty"`
+}
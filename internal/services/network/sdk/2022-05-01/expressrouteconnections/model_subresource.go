@@ -0,0 +1,5 @@
+package expressrouteconnections
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,6 @@
+package expressrouteconnections
+
+type VnetRoute struct {
+	BgpConnections *[]SubResource `json:"bgpConnections,omitempty"`
+	StaticRoutes   *[]StaticRoute `json:"staticRoutes,omitempty"`
+}
@@ -0,0 +1,15 @@
+package expressrouteportauthorizations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ExpressRoutePortAuthorizationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewExpressRoutePortAuthorizationsClientWithBaseURI(endpoint string) ExpressRoutePortAuthorizationsClient {
+	return ExpressRoutePortAuthorizationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
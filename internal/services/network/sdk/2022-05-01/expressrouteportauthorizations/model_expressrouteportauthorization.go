@@ -0,0 +1,9 @@
+package expressrouteportauthorizations
+
+type ExpressRoutePortAuthorization struct {
+	Etag       *string                                        `json:"etag,omitempty"`
+	Id         *string                                        `json:"id,omitempty"`
+	Name       *string                                        `json:"name,omitempty"`
+	Properties *ExpressRoutePortAuthorizationPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                                        `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package expressrouteportauthorizations
+
+type ExpressRoutePortAuthorizationPropertiesFormat struct {
+	AuthorizationKey       *string                                 `json:"authorizationKey,omitempty"`
+	AuthorizationUseStatus *ExpressRoutePortAuthorizationUseStatus `json:"authorizationUseStatus,omitempty"`
+	CircuitResourceUri     *string                                 `json:"circuitResourceUri,omitempty"`
+	ProvisioningState      *ProvisioningState                      `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,15 @@
+package hubvirtualnetworkconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type HubVirtualNetworkConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewHubVirtualNetworkConnectionsClientWithBaseURI(endpoint string) HubVirtualNetworkConnectionsClient {
+	return HubVirtualNetworkConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package hubvirtualnetworkconnections
+
+type HubVirtualNetworkConnection struct {
+	Etag       *string                                `json:"etag,omitempty"`
+	Id         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Properties *HubVirtualNetworkConnectionProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,10 @@
+package hubvirtualnetworkconnections
+
+type HubVirtualNetworkConnectionProperties struct {
+	AllowHubToRemoteVnetTransit         *bool                 `json:"allowHubToRemoteVnetTransit,omitempty"`
+	AllowRemoteVnetToUseHubVnetGateways *bool                 `json:"allowRemoteVnetToUseHubVnetGateways,omitempty"`
+	EnableInternetSecurity              *bool                 `json:"enableInternetSecurity,omitempty"`
+	ProvisioningState                   *ProvisioningState    `json:"provisioningState,omitempty"`
+	RemoteVirtualNetwork                *SubResource          `json:"remoteVirtualNetwork,omitempty"`
+	RoutingConfiguration                *RoutingConfiguration `json:"routingConfiguration,omitempty"`
+}
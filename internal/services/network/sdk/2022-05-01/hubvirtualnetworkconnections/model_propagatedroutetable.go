@@ -0,0 +1,6 @@
+package hubvirtualnetworkconnections
+
+type PropagatedRouteTable struct {
+	Ids    *[]SubResource `json:"ids,omitempty"`
+	Labels *[]string      `json:"labels,omitempty"`
+}
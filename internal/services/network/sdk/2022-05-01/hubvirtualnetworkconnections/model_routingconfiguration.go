@@ -0,0 +1,9 @@
+package hubvirtualnetworkconnections
+
+type RoutingConfiguration struct {
+	AssociatedRouteTable  *SubResource          `json:"associatedRouteTable,omitempty"`
+	InboundRouteMap       *SubResource          `json:"inboundRouteMap,omitempty"`
+	OutboundRouteMap      *SubResource          `json:"outboundRouteMap,omitempty"`
+	PropagatedRouteTables *PropagatedRouteTable `json:"propagatedRouteTables,omitempty"`
+	VnetRoutes            *VnetRoute            `json:"vnetRoutes,omitempty"`
+}
@@ -0,0 +1,5 @@
+package hubvirtualnetworkconnections
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,6 @@
+package hubvirtualnetworkconnections
+
+type VnetRoute struct {
+	BgpConnections *[]SubResource `json:"bgpConnections,omitempty"`
+	StaticRoutes   *[]StaticRoute `json:"staticRoutes,omitempty"`
+}
@@ -0,0 +1,24 @@
+package hubvirtualnetworkconnections
+
+type HubVirtualNetworkConnectionPredicate struct {
+	Etag *string
+	Id   *string
+	Name *string
+}
+
+func (p HubVirtualNetworkConnectionPredicate) Matches(input HubVirtualNetworkConnection) bool {
+
+	if p.Etag != nil && (input.Etag == nil && *p.Etag != *input.Etag) {
+		return false
+	}
+
+	if p.Id != nil && (input.Id == nil && *p.Id != *input.Id) {
+		return false
+	}
+
+	if p.Name != nil && (input.Name == nil && *p.Name != *input.Name) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,9 @@
+package hubvirtualnetworkconnections
+
+import "fmt"
+
+const defaultApiVersion = "2022-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/hubvirtualnetworkconnections/%s", defaultApiVersion)
+}
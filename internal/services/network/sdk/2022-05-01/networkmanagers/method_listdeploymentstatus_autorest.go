@@ -0,0 +1,66 @@
+package networkmanagers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListDeploymentStatusResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkManagerDeploymentStatusListResult
+}
+
+// ListDeploymentStatus ...
+func (c NetworkManagersClient) ListDeploymentStatus(ctx context.Context, id NetworkManagerId, input NetworkManagerDeploymentStatusParameter) (result ListDeploymentStatusResponse, err error) {
+	req, err := c.preparerForListDeploymentStatus(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ListDeploymentStatus", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ListDeploymentStatus", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListDeploymentStatus(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagers.NetworkManagersClient", "ListDeploymentStatus", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListDeploymentStatus prepares the ListDeploymentStatus request.
+func (c NetworkManagersClient) preparerForListDeploymentStatus(ctx context.Context, id NetworkManagerId, input NetworkManagerDeploymentStatusParameter) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/listDeploymentStatus", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListDeploymentStatus handles the response to the ListDeploymentStatus request. The method always
+// closes the http.Response Body.
+func (c NetworkManagersClient) responderForListDeploymentStatus(resp *http.Response) (result ListDeploymentStatusResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
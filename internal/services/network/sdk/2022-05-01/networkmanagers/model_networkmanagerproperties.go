@@ -0,0 +1,8 @@
+package networkmanagers
+
+type NetworkManagerProperties struct {
+	Description                 *string                                      `json:"description,omitempty"`
+	NetworkManagerScopeAccesses []ConfigurationType                          `json:"networkManagerScopeAccesses"`
+	NetworkManagerScopes        NetworkManagerPropertiesNetworkManagerScopes `json:"networkManagerScopes"`
+	ProvisioningState           *ProvisioningState                           `json:"provisioningState,omitempty"`
+}
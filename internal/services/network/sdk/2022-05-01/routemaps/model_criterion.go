@@ -0,0 +1,8 @@
+package routemaps
+
+type Criterion struct {
+	AsPath         *[]string               `json:"asPath,omitempty"`
+	Community      *[]string               `json:"community,omitempty"`
+	MatchCondition *RouteMapMatchCondition `json:"matchCondition,omitempty"`
+	RoutePrefix    *[]string               `json:"routePrefix,omitempty"`
+}
@@ -0,0 +1,8 @@
+package routemaps
+
+type RouteMapProperties struct {
+	AssociatedInboundConnections  *[]string          `json:"associatedInboundConnections,omitempty"`
+	AssociatedOutboundConnections *[]string          `json:"associatedOutboundConnections,omitempty"`
+	ProvisioningState             *ProvisioningState `json:"provisioningState,omitempty"`
+	Rules                         *[]RouteMapRule    `json:"rules,omitempty"`
+}
@@ -0,0 +1,8 @@
+package staticmembers
+
+type StaticMember struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *StaticMemberProperties `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
@@ -0,0 +1,272 @@
+package network
+
+import (
+	"net"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestNextAvailableSubnetAddressPrefix(t *testing.T) {
+	testData := []struct {
+		name         string
+		space        string
+		allocated    []string
+		prefixLength int
+		expected     string
+	}{
+		{
+			name:         "empty address space",
+			space:        "10.0.0.0/16",
+			prefixLength: 24,
+			expected:     "10.0.0.0/24",
+		},
+		{
+			name:         "prefix length matching the address space",
+			space:        "10.0.0.0/24",
+			prefixLength: 24,
+			expected:     "10.0.0.0/24",
+		},
+		{
+			name:         "skips an overlapping range",
+			space:        "10.0.0.0/16",
+			allocated:    []string{"10.0.0.0/24"},
+			prefixLength: 24,
+			expected:     "10.0.1.0/24",
+		},
+		{
+			name:         "skips a larger overlapping range",
+			space:        "10.0.0.0/16",
+			allocated:    []string{"10.0.0.0/22"},
+			prefixLength: 26,
+			expected:     "10.0.4.0/26",
+		},
+		{
+			name:         "realigns after a smaller allocated range",
+			space:        "10.0.0.0/16",
+			allocated:    []string{"10.0.0.0/24", "10.0.1.0/26"},
+			prefixLength: 24,
+			expected:     "10.0.2.0/24",
+		},
+		{
+			name:         "fills the gap after a smaller allocated range",
+			space:        "10.0.0.0/16",
+			allocated:    []string{"10.0.0.0/24", "10.0.1.0/26"},
+			prefixLength: 26,
+			expected:     "10.0.1.64/26",
+		},
+		{
+			name:         "uses a gap between allocated ranges",
+			space:        "10.0.0.0/16",
+			allocated:    []string{"10.0.2.0/24", "10.0.0.0/24"},
+			prefixLength: 24,
+			expected:     "10.0.1.0/24",
+		},
+		{
+			name:         "ignores ranges outside of the address space",
+			space:        "10.0.0.0/16",
+			allocated:    []string{"10.1.0.0/24", "192.168.0.0/24"},
+			prefixLength: 24,
+			expected:     "10.0.0.0/24",
+		},
+		{
+			name:         "ignores IPv6 ranges",
+			space:        "10.0.0.0/16",
+			allocated:    []string{"ace:cab:deca::/64"},
+			prefixLength: 24,
+			expected:     "10.0.0.0/24",
+		},
+		{
+			name:         "exhausted address space",
+			space:        "10.0.0.0/24",
+			allocated:    []string{"10.0.0.0/25", "10.0.0.128/25"},
+			prefixLength: 26,
+		},
+		{
+			name:         "remaining space is too small",
+			space:        "10.0.0.0/24",
+			allocated:    []string{"10.0.0.0/25"},
+			prefixLength: 24,
+		},
+		{
+			name:         "prefix length shorter than the address space",
+			space:        "10.0.0.0/24",
+			prefixLength: 16,
+		},
+		{
+			name:         "end of the IPv4 address space",
+			space:        "255.255.255.0/24",
+			allocated:    []string{"255.255.255.0/25"},
+			prefixLength: 25,
+			expected:     "255.255.255.128/25",
+		},
+		{
+			name:         "exhausted at the end of the IPv4 address space",
+			space:        "255.255.255.0/24",
+			allocated:    []string{"255.255.255.0/25", "255.255.255.128/25"},
+			prefixLength: 25,
+		},
+		{
+			name:         "IPv6 address space",
+			space:        "ace:cab:deca::/48",
+			prefixLength: 64,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			_, space, err := net.ParseCIDR(v.space)
+			if err != nil {
+				t.Fatalf("parsing %q: %+v", v.space, err)
+			}
+
+			allocated := make([]*net.IPNet, 0)
+			for _, prefix := range v.allocated {
+				_, ipNet, err := net.ParseCIDR(prefix)
+				if err != nil {
+					t.Fatalf("parsing %q: %+v", prefix, err)
+				}
+				allocated = append(allocated, ipNet)
+			}
+
+			actual := nextAvailableSubnetAddressPrefix(space, allocated, v.prefixLength)
+			if v.expected == "" {
+				if actual != nil {
+					t.Fatalf("expected no address prefix but got %q", actual.String())
+				}
+				return
+			}
+
+			if actual == nil {
+				t.Fatalf("expected %q but got no address prefix", v.expected)
+			}
+			if actual.String() != v.expected {
+				t.Fatalf("expected %q but got %q", v.expected, actual.String())
+			}
+		})
+	}
+}
+
+func TestNextAvailableVirtualNetworkAddressPrefix(t *testing.T) {
+	testData := []struct {
+		name          string
+		addressSpaces []string
+		subnets       []network.Subnet
+		prefixLength  int
+		expected      string
+		expectError   bool
+	}{
+		{
+			name:         "no address space",
+			prefixLength: 24,
+			expectError:  true,
+		},
+		{
+			name:          "no subnets",
+			addressSpaces: []string{"10.0.0.0/16"},
+			prefixLength:  24,
+			expected:      "10.0.0.0/24",
+		},
+		{
+			name:          "subnets using address_prefix and address_prefixes",
+			addressSpaces: []string{"10.0.0.0/16"},
+			subnets: []network.Subnet{
+				{
+					SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+						AddressPrefix: utils.String("10.0.0.0/24"),
+					},
+				},
+				{
+					SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+						AddressPrefixes: &[]string{"10.0.1.0/24", "10.0.2.0/25"},
+					},
+				},
+				{
+					SubnetPropertiesFormat: nil,
+				},
+			},
+			prefixLength: 24,
+			expected:     "10.0.3.0/24",
+		},
+		{
+			name:          "first address space is exhausted",
+			addressSpaces: []string{"10.0.0.0/24", "10.1.0.0/16"},
+			subnets: []network.Subnet{
+				{
+					SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+						AddressPrefix: utils.String("10.0.0.0/24"),
+					},
+				},
+			},
+			prefixLength: 24,
+			expected:     "10.1.0.0/24",
+		},
+		{
+			name:          "first address space is shorter than the prefix length",
+			addressSpaces: []string{"10.0.0.0/26", "10.1.0.0/16"},
+			prefixLength:  24,
+			expected:      "10.1.0.0/24",
+		},
+		{
+			name:          "IPv6 address space is skipped",
+			addressSpaces: []string{"ace:cab:deca::/48", "10.0.0.0/16"},
+			prefixLength:  24,
+			expected:      "10.0.0.0/24",
+		},
+		{
+			name:          "all address spaces are exhausted",
+			addressSpaces: []string{"10.0.0.0/24", "10.1.0.0/24"},
+			subnets: []network.Subnet{
+				{
+					SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+						AddressPrefixes: &[]string{"10.0.0.0/24", "10.1.0.0/25"},
+					},
+				},
+			},
+			prefixLength: 24,
+			expectError:  true,
+		},
+		{
+			name:          "invalid subnet address prefix",
+			addressSpaces: []string{"10.0.0.0/16"},
+			subnets: []network.Subnet{
+				{
+					SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+						AddressPrefix: utils.String("10.0.0.0"),
+					},
+				},
+			},
+			prefixLength: 24,
+			expectError:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			props := &network.VirtualNetworkPropertiesFormat{
+				Subnets: &v.subnets,
+			}
+			if v.addressSpaces != nil {
+				props.AddressSpace = &network.AddressSpace{
+					AddressPrefixes: &v.addressSpaces,
+				}
+			}
+
+			actual, err := nextAvailableVirtualNetworkAddressPrefix(props, v.prefixLength)
+			if v.expectError {
+				if err == nil {
+					t.Fatalf("expected an error but got %q", actual)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected %q but got an error: %+v", v.expected, err)
+			}
+			if actual != v.expected {
+				t.Fatalf("expected %q but got %q", v.expected, actual)
+			}
+		})
+	}
+}
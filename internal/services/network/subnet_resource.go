@@ -2,8 +2,10 @@ package network
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"log"
+	"net"
 	"strings"
 	"time"
 
@@ -66,6 +68,15 @@ func resourceSubnet() *pluginsdk.Resource {
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
+				ConflictsWith: []string{"prefix_length"},
+			},
+
+			"prefix_length": {
+				Type:          pluginsdk.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(8, 29),
+				ConflictsWith: []string{"address_prefixes"},
 			},
 
 			"service_endpoints": func() *pluginsdk.Schema {
@@ -210,7 +221,14 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	properties := network.SubnetPropertiesFormat{}
-	if value, ok := d.GetOk("address_prefixes"); ok {
+	if value, ok := d.GetOk("prefix_length"); ok {
+		// the Virtual Network is locked above, so no other Subnet can be allocated the same range in the meantime
+		addressPrefix, err := allocateSubnetAddressPrefix(ctx, vnetClient, id, value.(int))
+		if err != nil {
+			return err
+		}
+		properties.AddressPrefix = utils.String(addressPrefix)
+	} else if value, ok := d.GetOk("address_prefixes"); ok {
 		var addressPrefixes []string
 		for _, item := range value.([]interface{}) {
 			addressPrefixes = append(addressPrefixes, item.(string))
@@ -643,3 +661,125 @@ func SubnetProvisioningStateRefreshFunc(ctx context.Context, client *network.Sub
 		return res, string(res.ProvisioningState), nil
 	}
 }
+
+// allocateSubnetAddressPrefix returns the first IPv4 range of the specified prefix length within the address space of
+// the Virtual Network which doesn't overlap with any of the Subnets which already exist within it
+func allocateSubnetAddressPrefix(ctx context.Context, client *network.VirtualNetworksClient, id parse.SubnetId, prefixLength int) (string, error) {
+	vnetId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	vnet, err := client.Get(ctx, vnetId.ResourceGroup, vnetId.Name, "")
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s to allocate an address prefix for %s: %+v", vnetId, id, err)
+	}
+
+	prefix, err := nextAvailableVirtualNetworkAddressPrefix(vnet.VirtualNetworkPropertiesFormat, prefixLength)
+	if err != nil {
+		return "", fmt.Errorf("allocating an address prefix for %s within %s: %+v", id, vnetId, err)
+	}
+
+	return prefix, nil
+}
+
+// nextAvailableVirtualNetworkAddressPrefix returns the first IPv4 range of the specified prefix length within any of
+// the address spaces of the Virtual Network, in order, which doesn't overlap with any of its existing Subnets
+func nextAvailableVirtualNetworkAddressPrefix(props *network.VirtualNetworkPropertiesFormat, prefixLength int) (string, error) {
+	if props == nil || props.AddressSpace == nil || props.AddressSpace.AddressPrefixes == nil {
+		return "", fmt.Errorf("`address_space` was nil")
+	}
+
+	allocated := make([]*net.IPNet, 0)
+	if props.Subnets != nil {
+		for _, subnet := range *props.Subnets {
+			if subnet.SubnetPropertiesFormat == nil {
+				continue
+			}
+
+			prefixes := make([]string, 0)
+			if subnet.AddressPrefix != nil {
+				prefixes = append(prefixes, *subnet.AddressPrefix)
+			}
+			if subnet.AddressPrefixes != nil {
+				prefixes = append(prefixes, *subnet.AddressPrefixes...)
+			}
+			for _, prefix := range prefixes {
+				_, ipNet, err := net.ParseCIDR(prefix)
+				if err != nil {
+					return "", fmt.Errorf("parsing the address prefix %q of an existing Subnet: %+v", prefix, err)
+				}
+				allocated = append(allocated, ipNet)
+			}
+		}
+	}
+
+	for _, addressSpace := range *props.AddressSpace.AddressPrefixes {
+		_, space, err := net.ParseCIDR(addressSpace)
+		if err != nil {
+			return "", fmt.Errorf("parsing the address space %q: %+v", addressSpace, err)
+		}
+
+		if prefix := nextAvailableSubnetAddressPrefix(space, allocated, prefixLength); prefix != nil {
+			return prefix.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("no free range with a prefix length of %d was found within the address space", prefixLength)
+}
+
+// nextAvailableSubnetAddressPrefix returns the first IPv4 range of the specified prefix length within `space` which
+// doesn't overlap any of the `allocated` ranges, or nil when the address space is exhausted
+func nextAvailableSubnetAddressPrefix(space *net.IPNet, allocated []*net.IPNet, prefixLength int) *net.IPNet {
+	spaceStart, spaceEnd, ok := ipv4NetworkRange(space)
+	if !ok {
+		return nil
+	}
+
+	if spaceLength, _ := space.Mask.Size(); prefixLength < spaceLength {
+		return nil
+	}
+
+	size := uint64(1) << uint(32-prefixLength)
+	candidate := spaceStart
+	for candidate+size <= spaceEnd {
+		var overlappingEnd uint64
+		for _, existing := range allocated {
+			existingStart, existingEnd, ok := ipv4NetworkRange(existing)
+			if !ok {
+				continue
+			}
+
+			if candidate < existingEnd && existingStart < candidate+size {
+				overlappingEnd = existingEnd
+				break
+			}
+		}
+
+		if overlappingEnd == 0 {
+			ip := make(net.IP, net.IPv4len)
+			binary.BigEndian.PutUint32(ip, uint32(candidate))
+			return &net.IPNet{
+				IP:   ip,
+				Mask: net.CIDRMask(prefixLength, 32),
+			}
+		}
+
+		// skip past the overlapping range, realigning to the requested prefix length
+		candidate = (overlappingEnd + size - 1) / size * size
+	}
+
+	return nil
+}
+
+// ipv4NetworkRange returns the first address of the IPv4 range and the address immediately after it
+func ipv4NetworkRange(input *net.IPNet) (uint64, uint64, bool) {
+	ip := input.IP.To4()
+	if ip == nil {
+		return 0, 0, false
+	}
+
+	ones, bits := input.Mask.Size()
+	if bits != 32 {
+		return 0, 0, false
+	}
+
+	start := uint64(binary.BigEndian.Uint32(ip))
+	return start, start + uint64(1)<<uint(32-ones), true
+}
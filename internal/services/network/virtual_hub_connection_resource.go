@@ -7,11 +7,14 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/hubvirtualnetworkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/routemaps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -120,6 +123,18 @@ func resourceVirtualHubConnectionSchema() map[string]*pluginsdk.Schema {
 						AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
 					},
 
+					"inbound_route_map_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: routemaps.ValidateRouteMapID,
+					},
+
+					"outbound_route_map_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: routemaps.ValidateRouteMapID,
+					},
+
 					//lintignore:XS003
 					"static_vnet_route": {
 						Type:     pluginsdk.TypeList,
@@ -193,47 +208,44 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta
 	locks.ByName(remoteVirtualNetworkId.Name, VirtualNetworkResourceName)
 	defer locks.UnlockByName(remoteVirtualNetworkId.Name, VirtualNetworkResourceName)
 
+	sdkId := hubvirtualnetworkconnections.NewHubVirtualNetworkConnectionID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
+
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+		existing, err := client.Get(ctx, sdkId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of %s: %+v", id, err)
 			}
 		}
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_virtual_hub_connection", id.ID())
 		}
 	}
 
-	connection := network.HubVirtualNetworkConnection{
+	connection := hubvirtualnetworkconnections.HubVirtualNetworkConnection{
 		Name: utils.String(id.Name),
-		HubVirtualNetworkConnectionProperties: &network.HubVirtualNetworkConnectionProperties{
-			RemoteVirtualNetwork: &network.SubResource{
-				ID: utils.String(remoteVirtualNetworkId.ID()),
+		Properties: &hubvirtualnetworkconnections.HubVirtualNetworkConnectionProperties{
+			RemoteVirtualNetwork: &hubvirtualnetworkconnections.SubResource{
+				Id: utils.String(remoteVirtualNetworkId.ID()),
 			},
 			EnableInternetSecurity: utils.Bool(d.Get("internet_security_enabled").(bool)),
 		},
 	}
 
 	if v, ok := d.GetOk("routing"); ok {
-		connection.HubVirtualNetworkConnectionProperties.RoutingConfiguration = expandVirtualHubConnectionRouting(v.([]interface{}))
+		connection.Properties.RoutingConfiguration = expandVirtualHubConnectionRouting(v.([]interface{}))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, id.Name, connection)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, sdkId, connection); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
-	}
-
 	timeout, _ := ctx.Deadline()
 
 	vnetStateConf := &pluginsdk.StateChangeConf{
-		Pending:    []string{string(network.ProvisioningStateUpdating)},
-		Target:     []string{string(network.ProvisioningStateSucceeded)},
-		Refresh:    virtualHubConnectionProvisioningStateRefreshFunc(ctx, client, id),
+		Pending:    []string{string(hubvirtualnetworkconnections.ProvisioningStateUpdating)},
+		Target:     []string{string(hubvirtualnetworkconnections.ProvisioningStateSucceeded)},
+		Refresh:    virtualHubConnectionProvisioningStateRefreshFunc(ctx, client, sdkId),
 		MinTimeout: 1 * time.Minute,
 		Timeout:    time.Until(timeout),
 	}
@@ -256,9 +268,9 @@ func resourceVirtualHubConnectionRead(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	resp, err := client.Get(ctx, hubvirtualnetworkconnections.NewHubVirtualNetworkConnectionID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] %s does not exist - removing from state", *id)
 			d.SetId("")
 			return nil
@@ -269,7 +281,8 @@ func resourceVirtualHubConnectionRead(d *pluginsdk.ResourceData, meta interface{
 	d.Set("name", id.Name)
 	d.Set("virtual_hub_id", parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
 
-	if props := resp.HubVirtualNetworkConnectionProperties; props != nil {
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
 
 		if !features.ThreePointOhBeta() {
 			// The following two attributes are deprecated by API (which will always return `true`).
@@ -280,8 +293,8 @@ func resourceVirtualHubConnectionRead(d *pluginsdk.ResourceData, meta interface{
 
 		d.Set("internet_security_enabled", props.EnableInternetSecurity)
 		remoteVirtualNetworkId := ""
-		if props.RemoteVirtualNetwork != nil && props.RemoteVirtualNetwork.ID != nil {
-			remoteVirtualNetworkId = *props.RemoteVirtualNetwork.ID
+		if props.RemoteVirtualNetwork != nil && props.RemoteVirtualNetwork.Id != nil {
+			remoteVirtualNetworkId = *props.RemoteVirtualNetwork.Id
 		}
 		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
 
@@ -306,29 +319,36 @@ func resourceVirtualHubConnectionDelete(d *pluginsdk.ResourceData, meta interfac
 	locks.ByName(id.VirtualHubName, virtualHubResourceName)
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, hubvirtualnetworkconnections.NewHubVirtualNetworkConnectionID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
-func expandVirtualHubConnectionRouting(input []interface{}) *network.RoutingConfiguration {
+func expandVirtualHubConnectionRouting(input []interface{}) *hubvirtualnetworkconnections.RoutingConfiguration {
 	if len(input) == 0 {
-		return &network.RoutingConfiguration{}
+		return &hubvirtualnetworkconnections.RoutingConfiguration{}
 	}
 
 	v := input[0].(map[string]interface{})
-	result := network.RoutingConfiguration{}
+	result := hubvirtualnetworkconnections.RoutingConfiguration{}
 
 	if associatedRouteTableId := v["associated_route_table_id"].(string); associatedRouteTableId != "" {
-		result.AssociatedRouteTable = &network.SubResource{
-			ID: utils.String(associatedRouteTableId),
+		result.AssociatedRouteTable = &hubvirtualnetworkconnections.SubResource{
+			Id: utils.String(associatedRouteTableId),
+		}
+	}
+
+	if inboundRouteMapId := v["inbound_route_map_id"].(string); inboundRouteMapId != "" {
+		result.InboundRouteMap = &hubvirtualnetworkconnections.SubResource{
+			Id: utils.String(inboundRouteMapId),
+		}
+	}
+
+	if outboundRouteMapId := v["outbound_route_map_id"].(string); outboundRouteMapId != "" {
+		result.OutboundRouteMap = &hubvirtualnetworkconnections.SubResource{
+			Id: utils.String(outboundRouteMapId),
 		}
 	}
 
@@ -343,32 +363,38 @@ func expandVirtualHubConnectionRouting(input []interface{}) *network.RoutingConf
 	return &result
 }
 
-func expandVirtualHubConnectionPropagatedRouteTable(input []interface{}) *network.PropagatedRouteTable {
+func expandVirtualHubConnectionPropagatedRouteTable(input []interface{}) *hubvirtualnetworkconnections.PropagatedRouteTable {
 	if len(input) == 0 {
-		return &network.PropagatedRouteTable{}
+		return &hubvirtualnetworkconnections.PropagatedRouteTable{}
 	}
 
 	v := input[0].(map[string]interface{})
 
-	result := network.PropagatedRouteTable{}
+	result := hubvirtualnetworkconnections.PropagatedRouteTable{}
 
 	if labels := v["labels"].(*pluginsdk.Set).List(); len(labels) != 0 {
 		result.Labels = utils.ExpandStringSlice(labels)
 	}
 
 	if routeTableIds := v["route_table_ids"].([]interface{}); len(routeTableIds) != 0 {
-		result.Ids = expandIDsToSubResources(routeTableIds)
+		ids := make([]hubvirtualnetworkconnections.SubResource, 0)
+		for _, id := range routeTableIds {
+			ids = append(ids, hubvirtualnetworkconnections.SubResource{
+				Id: utils.String(id.(string)),
+			})
+		}
+		result.Ids = &ids
 	}
 
 	return &result
 }
 
-func expandVirtualHubConnectionVnetStaticRoute(input []interface{}) *network.VnetRoute {
+func expandVirtualHubConnectionVnetStaticRoute(input []interface{}) *hubvirtualnetworkconnections.VnetRoute {
 	if len(input) == 0 {
-		return &network.VnetRoute{}
+		return &hubvirtualnetworkconnections.VnetRoute{}
 	}
 
-	results := make([]network.StaticRoute, 0)
+	results := make([]hubvirtualnetworkconnections.StaticRoute, 0)
 
 	for _, item := range input {
 		if item == nil {
@@ -377,7 +403,7 @@ func expandVirtualHubConnectionVnetStaticRoute(input []interface{}) *network.Vne
 
 		v := item.(map[string]interface{})
 
-		result := network.StaticRoute{}
+		result := hubvirtualnetworkconnections.StaticRoute{}
 
 		if name := v["name"].(string); name != "" {
 			result.Name = utils.String(name)
@@ -394,7 +420,7 @@ func expandVirtualHubConnectionVnetStaticRoute(input []interface{}) *network.Vne
 		results = append(results, result)
 	}
 
-	return &network.VnetRoute{
+	return &hubvirtualnetworkconnections.VnetRoute{
 		StaticRoutes: &results,
 	}
 }
@@ -411,26 +437,38 @@ func expandIDsToSubResources(input []interface{}) *[]network.SubResource {
 	return &ids
 }
 
-func flattenVirtualHubConnectionRouting(input *network.RoutingConfiguration) []interface{} {
+func flattenVirtualHubConnectionRouting(input *hubvirtualnetworkconnections.RoutingConfiguration) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
 	associatedRouteTableId := ""
-	if input.AssociatedRouteTable != nil && input.AssociatedRouteTable.ID != nil {
-		associatedRouteTableId = *input.AssociatedRouteTable.ID
+	if input.AssociatedRouteTable != nil && input.AssociatedRouteTable.Id != nil {
+		associatedRouteTableId = *input.AssociatedRouteTable.Id
+	}
+
+	inboundRouteMapId := ""
+	if input.InboundRouteMap != nil && input.InboundRouteMap.Id != nil {
+		inboundRouteMapId = *input.InboundRouteMap.Id
+	}
+
+	outboundRouteMapId := ""
+	if input.OutboundRouteMap != nil && input.OutboundRouteMap.Id != nil {
+		outboundRouteMapId = *input.OutboundRouteMap.Id
 	}
 
 	return []interface{}{
 		map[string]interface{}{
 			"associated_route_table_id": associatedRouteTableId,
+			"inbound_route_map_id":      inboundRouteMapId,
+			"outbound_route_map_id":     outboundRouteMapId,
 			"propagated_route_table":    flattenVirtualHubConnectionPropagatedRouteTable(input.PropagatedRouteTables),
 			"static_vnet_route":         flattenVirtualHubConnectionVnetStaticRoute(input.VnetRoutes),
 		},
 	}
 }
 
-func flattenVirtualHubConnectionPropagatedRouteTable(input *network.PropagatedRouteTable) []interface{} {
+func flattenVirtualHubConnectionPropagatedRouteTable(input *hubvirtualnetworkconnections.PropagatedRouteTable) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
@@ -442,7 +480,13 @@ func flattenVirtualHubConnectionPropagatedRouteTable(input *network.PropagatedRo
 
 	routeTableIds := make([]interface{}, 0)
 	if input.Ids != nil {
-		routeTableIds = flattenSubResourcesToIDs(input.Ids)
+		for _, v := range *input.Ids {
+			if v.Id == nil {
+				continue
+			}
+
+			routeTableIds = append(routeTableIds, *v.Id)
+		}
 	}
 
 	return []interface{}{
@@ -453,7 +497,7 @@ func flattenVirtualHubConnectionPropagatedRouteTable(input *network.PropagatedRo
 	}
 }
 
-func flattenVirtualHubConnectionVnetStaticRoute(input *network.VnetRoute) []interface{} {
+func flattenVirtualHubConnectionVnetStaticRoute(input *hubvirtualnetworkconnections.VnetRoute) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil || input.StaticRoutes == nil {
 		return results
@@ -504,13 +548,18 @@ func flattenSubResourcesToIDs(input *[]network.SubResource) []interface{} {
 	return ids
 }
 
-func virtualHubConnectionProvisioningStateRefreshFunc(ctx context.Context, client *network.HubVirtualNetworkConnectionsClient, id parse.HubVirtualNetworkConnectionId) pluginsdk.StateRefreshFunc {
+func virtualHubConnectionProvisioningStateRefreshFunc(ctx context.Context, client *hubvirtualnetworkconnections.HubVirtualNetworkConnectionsClient, id hubvirtualnetworkconnections.HubVirtualNetworkConnectionId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+		res, err := client.Get(ctx, id)
 		if err != nil {
 			return nil, "", fmt.Errorf("polling for %s: %+v", id, err)
 		}
 
-		return res, string(res.ProvisioningState), nil
+		provisioningState := ""
+		if model := res.Model; model != nil && model.Properties != nil && model.Properties.ProvisioningState != nil {
+			provisioningState = string(*model.Properties.ProvisioningState)
+		}
+
+		return res, provisioningState, nil
 	}
 }
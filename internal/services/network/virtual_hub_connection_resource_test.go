@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-05-01/hubvirtualnetworkconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -253,27 +255,43 @@ func (t VirtualHubConnectionResource) Exists(ctx context.Context, clients *clien
 		return nil, err
 	}
 
-	resp, err := clients.Network.HubVirtualNetworkConnectionClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	resp, err := clients.Network.HubVirtualNetworkConnectionClient.Get(ctx, hubvirtualnetworkconnections.NewHubVirtualNetworkConnectionID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual Hub Network Connection (%s): %+v", id, err)
 	}
 
-	return utils.Bool(resp.ID != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
 }
 
 func checkVirtualHubConnectionDoesNotExist(resourceGroupName, vhubName, vhubConnectionName string) acceptance.ClientCheckFunc {
 	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
-		if resp, err := clients.Network.HubVirtualNetworkConnectionClient.Get(ctx, resourceGroupName, vhubName, vhubConnectionName); err != nil {
-			if utils.ResponseWasNotFound(resp.Response) {
+		id := hubvirtualnetworkconnections.NewHubVirtualNetworkConnectionID(clients.Account.SubscriptionId, resourceGroupName, vhubName, vhubConnectionName)
+		if resp, err := clients.Network.HubVirtualNetworkConnectionClient.Get(ctx, id); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
 				return nil
 			}
-			return fmt.Errorf("Bad: Get on network.HubVirtualNetworkConnectionClient: %+v", err)
+			return fmt.Errorf("Bad: Get on hubvirtualnetworkconnections.HubVirtualNetworkConnectionsClient: %+v", err)
 		}
 
 		return fmt.Errorf("Bad: Virtual Hub Connection %q (Resource Group %q) still exists", vhubConnectionName, resourceGroupName)
 	}
 }
 
+func TestAccVirtualHubConnection_routeMap(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_connection", "test")
+	r := VirtualHubConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.routeMap(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r VirtualHubConnectionResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -566,3 +584,62 @@ resource "azurerm_virtual_hub_connection" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r VirtualHubConnectionResource) routeMap(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_route_map" "inbound" {
+  name           = "acctestrm-in-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  rule {
+    name                 = "rule1"
+    next_step_if_matched = "Continue"
+
+    action {
+      type = "Add"
+
+      parameter {
+        as_path = ["22334"]
+      }
+    }
+
+    match_criterion {
+      match_condition = "Contains"
+      route_prefix    = ["10.0.0.0/8"]
+    }
+  }
+}
+
+resource "azurerm_route_map" "outbound" {
+  name           = "acctestrm-out-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  rule {
+    name                 = "rule1"
+    next_step_if_matched = "Terminate"
+
+    action {
+      type = "Drop"
+    }
+
+    match_criterion {
+      match_condition = "Equals"
+      route_prefix    = ["10.1.0.0/16"]
+    }
+  }
+}
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctest-vhubconn-%[2]d"
+  virtual_hub_id            = azurerm_virtual_hub.test.id
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+
+  routing {
+    inbound_route_map_id  = azurerm_route_map.inbound.id
+    outbound_route_map_id = azurerm_route_map.outbound.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
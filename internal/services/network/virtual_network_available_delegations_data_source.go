@@ -0,0 +1,122 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceVirtualNetworkAvailableDelegations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkAvailableDelegationsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": commonschema.Location(),
+
+			"resource_group_name": commonschema.ResourceGroupNameOptional(),
+
+			"delegation": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"service_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"actions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualNetworkAvailableDelegationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.AvailableDelegationsClient
+	resourceGroupClient := meta.(*clients.Client).Network.AvailableResourceGroupDelegationsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loc := location.Normalize(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	// the delegations available within a Resource Group can differ from those available within the Subscription
+	id := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Network/locations/%s/availableDelegations", subscriptionId, loc)
+	delegations := make([]interface{}, 0)
+	if resourceGroup != "" {
+		id = fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/locations/%s/availableDelegations", subscriptionId, resourceGroup, loc)
+		iterator, err := resourceGroupClient.ListComplete(ctx, loc, resourceGroup)
+		if err != nil {
+			return fmt.Errorf("listing Available Delegations for Location %q (Resource Group %q): %+v", loc, resourceGroup, err)
+		}
+		for iterator.NotDone() {
+			delegations = append(delegations, flattenVirtualNetworkAvailableDelegation(iterator.Value()))
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Available Delegations for Location %q (Resource Group %q): %+v", loc, resourceGroup, err)
+			}
+		}
+	} else {
+		iterator, err := client.ListComplete(ctx, loc)
+		if err != nil {
+			return fmt.Errorf("listing Available Delegations for Location %q: %+v", loc, err)
+		}
+		for iterator.NotDone() {
+			delegations = append(delegations, flattenVirtualNetworkAvailableDelegation(iterator.Value()))
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Available Delegations for Location %q: %+v", loc, err)
+			}
+		}
+	}
+
+	d.SetId(id)
+
+	d.Set("location", loc)
+	if err := d.Set("delegation", delegations); err != nil {
+		return fmt.Errorf("setting `delegation`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenVirtualNetworkAvailableDelegation(input network.AvailableDelegation) map[string]interface{} {
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	serviceName := ""
+	if input.ServiceName != nil {
+		serviceName = *input.ServiceName
+	}
+
+	return map[string]interface{}{
+		"name":         name,
+		"service_name": serviceName,
+		"actions":      utils.FlattenStringSlice(input.Actions),
+	}
+}
@@ -0,0 +1,71 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkAvailableDelegationsDataSource struct{}
+
+func TestAccDataSourceVirtualNetworkAvailableDelegations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_available_delegations", "test")
+	r := VirtualNetworkAvailableDelegationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("delegation.#").Exists(),
+				check.That(data.ResourceName).Key("delegation.0.service_name").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualNetworkAvailableDelegations_resourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_available_delegations", "test")
+	r := VirtualNetworkAvailableDelegationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.resourceGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("delegation.#").Exists(),
+				check.That(data.ResourceName).Key("delegation.0.service_name").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkAvailableDelegationsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_network_available_delegations" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
+
+func (VirtualNetworkAvailableDelegationsDataSource) resourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+data "azurerm_virtual_network_available_delegations" "test" {
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var virtualNetworkGatewayResourceName = "azurerm_virtual_network_gateway"
+
 func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualNetworkGatewayCreateUpdate,
@@ -440,19 +443,20 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 
 	id := parse.NewVirtualNetworkGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
-		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
-			}
-		}
+	locks.ByName(id.Name, virtualNetworkGatewayResourceName)
+	defer locks.UnlockByName(id.Name, virtualNetworkGatewayResourceName)
 
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
 		if !utils.ResponseWasNotFound(existing.Response) {
-			return tf.ImportAsExistsError("azurerm_virtual_network_gateway", id.ID())
+			return fmt.Errorf("checking for presence of existing %s: %s", id, err)
 		}
 	}
 
+	if d.IsNewResource() && !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_virtual_network_gateway", id.ID())
+	}
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
@@ -461,6 +465,11 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
+	// the NAT Rules are managed using the `azurerm_virtual_network_gateway_nat_rule` resource, so must be retained
+	if existing.VirtualNetworkGatewayPropertiesFormat != nil {
+		properties.NatRules = existing.VirtualNetworkGatewayPropertiesFormat.NatRules
+	}
+
 	gateway := network.VirtualNetworkGateway{
 		Name:                                  &id.Name,
 		ExtendedLocation:                      expandEdgeZone(d.Get("edge_zone").(string)),
@@ -560,6 +569,9 @@ func resourceVirtualNetworkGatewayDelete(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
+	locks.ByName(id.Name, virtualNetworkGatewayResourceName)
+	defer locks.UnlockByName(id.Name, virtualNetworkGatewayResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -0,0 +1,280 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// Azure reserves the first four IP addresses (and the last) within each Subnet
+const subnetReservedLeadingIPAddressCount = 4
+
+func dataSourceVirtualNetworkIPAvailability() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkIPAvailabilityRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: networkValidate.VirtualNetworkID,
+			},
+
+			"ip_address": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.IPv4Address,
+			},
+
+			"ip_address_available": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"ip_address_platform_reserved": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"available_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"subnet": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"total_ip_address_count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"used_ip_address_count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"available_ip_address_count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"available_ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualNetworkIPAvailabilityRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkID(d.Get("virtual_network_id").(string))
+	if err != nil {
+		return err
+	}
+
+	vnet, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(vnet.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	usages := make(map[string]network.VirtualNetworkUsage)
+	iterator, err := client.ListUsageComplete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing usages for %s: %+v", id, err)
+	}
+	for iterator.NotDone() {
+		if usage := iterator.Value(); usage.ID != nil {
+			usages[strings.ToLower(*usage.ID)] = usage
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing usages for %s: %+v", id, err)
+		}
+	}
+
+	subnets := make([]interface{}, 0)
+	if props := vnet.VirtualNetworkPropertiesFormat; props != nil && props.Subnets != nil {
+		for _, subnet := range *props.Subnets {
+			flattened, err := flattenVirtualNetworkSubnetIPAvailability(ctx, client, *id, subnet, usages)
+			if err != nil {
+				return err
+			}
+			subnets = append(subnets, flattened)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("subnet", subnets); err != nil {
+		return fmt.Errorf("setting `subnet`: %+v", err)
+	}
+
+	ipAddressAvailable := false
+	ipAddressPlatformReserved := false
+	availableIPAddresses := make([]interface{}, 0)
+	if ipAddress := d.Get("ip_address").(string); ipAddress != "" {
+		resp, err := client.CheckIPAddressAvailability(ctx, id.ResourceGroup, id.Name, ipAddress)
+		if err != nil {
+			return fmt.Errorf("checking the availability of IP Address %q within %s: %+v", ipAddress, id, err)
+		}
+
+		if resp.Available != nil {
+			ipAddressAvailable = *resp.Available
+		}
+		if resp.IsPlatformReserved != nil {
+			ipAddressPlatformReserved = *resp.IsPlatformReserved
+		}
+		availableIPAddresses = utils.FlattenStringSlice(resp.AvailableIPAddresses)
+	}
+
+	d.Set("ip_address_available", ipAddressAvailable)
+	d.Set("ip_address_platform_reserved", ipAddressPlatformReserved)
+	if err := d.Set("available_ip_addresses", availableIPAddresses); err != nil {
+		return fmt.Errorf("setting `available_ip_addresses`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenVirtualNetworkSubnetIPAvailability(ctx context.Context, client *network.VirtualNetworksClient, id parse.VirtualNetworkId, subnet network.Subnet, usages map[string]network.VirtualNetworkUsage) (map[string]interface{}, error) {
+	name := ""
+	if subnet.Name != nil {
+		name = *subnet.Name
+	}
+
+	subnetId := ""
+	if subnet.ID != nil {
+		subnetId = *subnet.ID
+	}
+
+	addressPrefixes := make([]string, 0)
+	if props := subnet.SubnetPropertiesFormat; props != nil {
+		if props.AddressPrefix != nil {
+			addressPrefixes = append(addressPrefixes, *props.AddressPrefix)
+		}
+		if props.AddressPrefixes != nil {
+			addressPrefixes = append(addressPrefixes, *props.AddressPrefixes...)
+		}
+	}
+
+	totalCount := 0
+	usedCount := 0
+	if usage, ok := usages[strings.ToLower(subnetId)]; ok {
+		if usage.Limit != nil {
+			totalCount = int(*usage.Limit)
+		}
+		if usage.CurrentValue != nil {
+			usedCount = int(*usage.CurrentValue)
+		}
+	}
+
+	// the API returns a handful of free addresses when the requested address is taken, so probing the first
+	// usable address of the Subnet gives a sample of the addresses which are available for use
+	availableIPAddresses := make([]interface{}, 0)
+	if totalCount > usedCount {
+		for _, prefix := range addressPrefixes {
+			probe, ok := subnetFirstUsableIPv4Address(prefix)
+			if !ok {
+				continue
+			}
+
+			resp, err := client.CheckIPAddressAvailability(ctx, id.ResourceGroup, id.Name, probe)
+			if err != nil {
+				return nil, fmt.Errorf("checking the availability of IP Address %q within %s: %+v", probe, id, err)
+			}
+
+			if resp.Available != nil && *resp.Available {
+				availableIPAddresses = append(availableIPAddresses, probe)
+			}
+			availableIPAddresses = append(availableIPAddresses, utils.FlattenStringSlice(resp.AvailableIPAddresses)...)
+			break
+		}
+	}
+
+	return map[string]interface{}{
+		"name":                       name,
+		"id":                         subnetId,
+		"address_prefixes":           addressPrefixes,
+		"total_ip_address_count":     totalCount,
+		"used_ip_address_count":      usedCount,
+		"available_ip_address_count": totalCount - usedCount,
+		"available_ip_addresses":     availableIPAddresses,
+	}, nil
+}
+
+// subnetFirstUsableIPv4Address returns the first IP Address within the IPv4 CIDR which isn't reserved by Azure
+func subnetFirstUsableIPv4Address(cidr string) (string, bool) {
+	if _, errs := validate.CIDR(cidr, "address_prefix"); len(errs) > 0 {
+		return "", false
+	}
+
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", false
+	}
+
+	ip := ipNet.IP.To4()
+	if ip == nil {
+		return "", false
+	}
+
+	first := make(net.IP, len(ip))
+	copy(first, ip)
+	first[3] += subnetReservedLeadingIPAddressCount
+	if !ipNet.Contains(first) {
+		return "", false
+	}
+
+	return first.String(), true
+}
@@ -0,0 +1,104 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkIPAvailabilityDataSource struct{}
+
+func TestAccDataSourceVirtualNetworkIPAvailability_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_ip_availability", "test")
+	r := VirtualNetworkIPAvailabilityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("subnet.#").HasValue("1"),
+				check.That(data.ResourceName).Key("subnet.0.name").HasValue("subnet1"),
+				check.That(data.ResourceName).Key("subnet.0.total_ip_address_count").HasValue("251"),
+				check.That(data.ResourceName).Key("subnet.0.used_ip_address_count").HasValue("0"),
+				check.That(data.ResourceName).Key("subnet.0.available_ip_address_count").HasValue("251"),
+				check.That(data.ResourceName).Key("subnet.0.available_ip_addresses.0").HasValue("10.0.1.4"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualNetworkIPAvailability_ipAddress(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_ip_availability", "test")
+	r := VirtualNetworkIPAvailabilityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.ipAddress(data, "10.0.1.10"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("ip_address_available").HasValue("true"),
+				check.That(data.ResourceName).Key("ip_address_platform_reserved").HasValue("false"),
+			),
+		},
+		{
+			Config: r.ipAddress(data, "10.0.1.1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("ip_address_available").HasValue("false"),
+				check.That(data.ResourceName).Key("available_ip_addresses.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkIPAvailabilityDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "subnet1"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r VirtualNetworkIPAvailabilityDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_ip_availability" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+
+  depends_on = [azurerm_subnet.test]
+}
+`, r.template(data))
+}
+
+func (r VirtualNetworkIPAvailabilityDataSource) ipAddress(data acceptance.TestData, ipAddress string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_ip_availability" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  ip_address         = "%s"
+
+  depends_on = [azurerm_subnet.test]
+}
+`, r.template(data), ipAddress)
+}
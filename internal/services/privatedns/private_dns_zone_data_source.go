@@ -55,6 +55,61 @@ func dataSourcePrivateDnsZone() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"soa_record": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"email": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"expire_time": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"fqdn": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"host_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"minimum_ttl": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"refresh_time": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"retry_time": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"serial_number": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+
+						"ttl": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -62,6 +117,7 @@ func dataSourcePrivateDnsZone() *pluginsdk.Resource {
 
 func dataSourcePrivateDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).PrivateDns.PrivateZonesClient
+	recordSetsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -108,6 +164,15 @@ func dataSourcePrivateDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("max_number_of_virtual_network_links_with_registration", props.MaxNumberOfVirtualNetworkLinksWithRegistration)
 	}
 
+	recordSetResp, err := recordSetsClient.Get(ctx, resourceGroup, name, privatedns.SOA, "@")
+	if err != nil {
+		return fmt.Errorf("reading DNS SOA record @ for %s: %+v", resourceId, err)
+	}
+
+	if err := d.Set("soa_record", flattenPrivateDNSZoneSOARecord(&recordSetResp)); err != nil {
+		return fmt.Errorf("setting `soa_record`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -19,6 +19,8 @@ func TestAccDataSourcePrivateDNSZone_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("tags.%").HasValue("0"),
+				check.That(data.ResourceName).Key("soa_record.#").HasValue("1"),
+				check.That(data.ResourceName).Key("soa_record.0.email").Exists(),
 			),
 		},
 	})
@@ -0,0 +1,245 @@
+package privatedns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourcePrivateDnsZoneRecords() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePrivateDnsZoneRecordsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"zone_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"record_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(privatedns.A),
+					string(privatedns.AAAA),
+					string(privatedns.CNAME),
+					string(privatedns.MX),
+					string(privatedns.PTR),
+					string(privatedns.SOA),
+					string(privatedns.SRV),
+					string(privatedns.TXT),
+				}, false),
+			},
+
+			"record_sets": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"fqdn": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"records": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"is_auto_registered": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrivateDnsZoneRecordsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewPrivateDnsZoneID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string))
+
+	recordSets := make([]interface{}, 0)
+	if recordType := d.Get("record_type").(string); recordType != "" {
+		iterator, err := client.ListByTypeComplete(ctx, id.ResourceGroup, id.Name, privatedns.RecordType(recordType), nil, "")
+		if err != nil {
+			return fmt.Errorf("listing %s Record Sets for %s: %+v", recordType, id, err)
+		}
+		for iterator.NotDone() {
+			recordSets = append(recordSets, flattenPrivateDnsZoneRecordSet(iterator.Value()))
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing %s Record Sets for %s: %+v", recordType, id, err)
+			}
+		}
+	} else {
+		iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.Name, nil, "")
+		if err != nil {
+			return fmt.Errorf("listing Record Sets for %s: %+v", id, err)
+		}
+		for iterator.NotDone() {
+			recordSets = append(recordSets, flattenPrivateDnsZoneRecordSet(iterator.Value()))
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Record Sets for %s: %+v", id, err)
+			}
+		}
+	}
+
+	d.SetId(id.ID())
+	d.Set("zone_name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if err := d.Set("record_sets", recordSets); err != nil {
+		return fmt.Errorf("setting `record_sets`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenPrivateDnsZoneRecordSet(input privatedns.RecordSet) map[string]interface{} {
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	// the type is returned in the form `Microsoft.Network/privateDnsZones/A`
+	recordType := ""
+	if input.Type != nil {
+		recordType = (*input.Type)[strings.LastIndex(*input.Type, "/")+1:]
+	}
+
+	fqdn := ""
+	ttl := 0
+	isAutoRegistered := false
+	records := make([]interface{}, 0)
+	metadata := make(map[string]interface{})
+	if props := input.RecordSetProperties; props != nil {
+		if props.Fqdn != nil {
+			fqdn = *props.Fqdn
+		}
+		if props.TTL != nil {
+			ttl = int(*props.TTL)
+		}
+		if props.IsAutoRegistered != nil {
+			isAutoRegistered = *props.IsAutoRegistered
+		}
+		records = flattenPrivateDnsZoneRecordSetValues(props)
+		metadata = tags.Flatten(props.Metadata)
+	}
+
+	return map[string]interface{}{
+		"name":               name,
+		"type":               recordType,
+		"fqdn":               fqdn,
+		"ttl":                ttl,
+		"records":            records,
+		"is_auto_registered": isAutoRegistered,
+		"tags":               metadata,
+	}
+}
+
+// flattenPrivateDnsZoneRecordSetValues returns the values of each record within the Record Set in zone file notation
+func flattenPrivateDnsZoneRecordSetValues(input *privatedns.RecordSetProperties) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input.ARecords != nil {
+		for _, v := range *input.ARecords {
+			if v.Ipv4Address != nil {
+				results = append(results, *v.Ipv4Address)
+			}
+		}
+	}
+
+	if input.AaaaRecords != nil {
+		for _, v := range *input.AaaaRecords {
+			if v.Ipv6Address != nil {
+				results = append(results, *v.Ipv6Address)
+			}
+		}
+	}
+
+	if input.CnameRecord != nil && input.CnameRecord.Cname != nil {
+		results = append(results, *input.CnameRecord.Cname)
+	}
+
+	if input.MxRecords != nil {
+		for _, v := range *input.MxRecords {
+			if v.Preference != nil && v.Exchange != nil {
+				results = append(results, fmt.Sprintf("%d %s", *v.Preference, *v.Exchange))
+			}
+		}
+	}
+
+	if input.PtrRecords != nil {
+		for _, v := range *input.PtrRecords {
+			if v.Ptrdname != nil {
+				results = append(results, *v.Ptrdname)
+			}
+		}
+	}
+
+	if v := input.SoaRecord; v != nil {
+		if v.Host != nil && v.Email != nil && v.SerialNumber != nil && v.RefreshTime != nil && v.RetryTime != nil && v.ExpireTime != nil && v.MinimumTTL != nil {
+			results = append(results, fmt.Sprintf("%s %s %d %d %d %d %d", *v.Host, *v.Email, *v.SerialNumber, *v.RefreshTime, *v.RetryTime, *v.ExpireTime, *v.MinimumTTL))
+		}
+	}
+
+	if input.SrvRecords != nil {
+		for _, v := range *input.SrvRecords {
+			if v.Priority != nil && v.Weight != nil && v.Port != nil && v.Target != nil {
+				results = append(results, fmt.Sprintf("%d %d %d %s", *v.Priority, *v.Weight, *v.Port, *v.Target))
+			}
+		}
+	}
+
+	if input.TxtRecords != nil {
+		for _, v := range *input.TxtRecords {
+			if v.Value != nil {
+				results = append(results, strings.Join(*v.Value, ""))
+			}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,114 @@
+package privatedns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateDnsZoneRecordsDataSource struct{}
+
+func TestAccDataSourcePrivateDnsZoneRecords_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_dns_zone_records", "test")
+	r := PrivateDnsZoneRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				// the SOA record is created alongside the zone
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("3"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourcePrivateDnsZoneRecords_recordType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_dns_zone_records", "test")
+	r := PrivateDnsZoneRecordsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.recordType(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("record_sets.#").HasValue("1"),
+				check.That(data.ResourceName).Key("record_sets.0.name").HasValue("myarecord"),
+				check.That(data.ResourceName).Key("record_sets.0.type").HasValue("A"),
+				check.That(data.ResourceName).Key("record_sets.0.ttl").HasValue("300"),
+				check.That(data.ResourceName).Key("record_sets.0.records.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (PrivateDnsZoneRecordsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.internal"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_dns_a_record" "test" {
+  name                = "myarecord"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+  ttl                 = 300
+  records             = ["1.2.3.4", "1.2.4.5"]
+}
+
+resource "azurerm_private_dns_txt_record" "test" {
+  name                = "mytxtrecord"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    value = "Quick brown fox"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r PrivateDnsZoneRecordsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_dns_zone_records" "test" {
+  zone_name           = azurerm_private_dns_zone.test.name
+  resource_group_name = azurerm_private_dns_zone.test.resource_group_name
+
+  depends_on = [
+    azurerm_private_dns_a_record.test,
+    azurerm_private_dns_txt_record.test,
+  ]
+}
+`, r.template(data))
+}
+
+func (r PrivateDnsZoneRecordsDataSource) recordType(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_dns_zone_records" "test" {
+  zone_name           = azurerm_private_dns_zone.test.name
+  resource_group_name = azurerm_private_dns_zone.test.resource_group_name
+  record_type         = "A"
+
+  depends_on = [
+    azurerm_private_dns_a_record.test,
+    azurerm_private_dns_txt_record.test,
+  ]
+}
+`, r.template(data))
+}
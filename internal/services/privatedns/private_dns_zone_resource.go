@@ -70,7 +70,6 @@ func resourcePrivateDnsZone() *pluginsdk.Resource {
 				MaxItems: 1,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"email": {
@@ -185,7 +184,7 @@ func resourcePrivateDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("waiting for create/update of Private DNS Zone %q (Resource Group %q): %+v", resourceId.Name, resourceId.ResourceGroup, err)
 	}
 
-	if v, ok := d.GetOk("soa_record"); ok {
+	if v, ok := d.GetOk("soa_record"); ok && (d.IsNewResource() || d.HasChange("soa_record")) {
 		soaRecordRaw := v.([]interface{})[0].(map[string]interface{})
 		soaRecord := expandPrivateDNSZoneSOARecord(soaRecordRaw)
 		rsParameters := privatedns.RecordSet{
@@ -0,0 +1,38 @@
+package privatednsresolver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateDNSResolverDnsForwardingRulesetDataSource struct{}
+
+func TestAccPrivateDNSResolverDnsForwardingRulesetDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_dns_resolver_dns_forwarding_ruleset", "test")
+	d := PrivateDNSResolverDnsForwardingRulesetDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("private_dns_resolver_outbound_endpoint_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d PrivateDNSResolverDnsForwardingRulesetDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_dns_resolver_dns_forwarding_ruleset" "test" {
+  name                = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.name
+  resource_group_name = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.resource_group_name
+}
+`, PrivateDNSResolverDnsForwardingRulesetResource{}.complete(data))
+}
@@ -0,0 +1,223 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2022-07-01/outboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateDNSResolverDnsForwardingRulesetResource{}
+
+type PrivateDNSResolverDnsForwardingRulesetResource struct{}
+
+type PrivateDNSResolverDnsForwardingRulesetModel struct {
+	Name                                  string            `tfschema:"name"`
+	ResourceGroupName                     string            `tfschema:"resource_group_name"`
+	Location                              string            `tfschema:"location"`
+	PrivateDNSResolverOutboundEndpointIds []string          `tfschema:"private_dns_resolver_outbound_endpoint_ids"`
+	Tags                                  map[string]string `tfschema:"tags"`
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_dns_forwarding_ruleset"
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) ModelObject() interface{} {
+	return &PrivateDNSResolverDnsForwardingRulesetModel{}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dnsforwardingrulesets.ValidateDnsForwardingRulesetID
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"private_dns_resolver_outbound_endpoint_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: outboundendpoints.ValidateOutboundEndpointID,
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state PrivateDNSResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := dnsforwardingrulesets.NewDnsForwardingRulesetID(subscriptionId, state.ResourceGroupName, state.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := dnsforwardingrulesets.DnsForwardingRuleset{
+				Location: location.Normalize(state.Location),
+				Properties: dnsforwardingrulesets.DnsForwardingRulesetProperties{
+					DnsResolverOutboundEndpoints: expandDnsForwardingRulesetOutboundEndpoints(state.PrivateDNSResolverOutboundEndpointIds),
+				},
+				Tags: &state.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, input, dnsforwardingrulesets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDNSResolverDnsForwardingRulesetModel{
+				Name:              id.DnsForwardingRulesetName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.PrivateDNSResolverOutboundEndpointIds = flattenDnsForwardingRulesetOutboundEndpoints(model.Properties.DnsResolverOutboundEndpoints)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state PrivateDNSResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			patch := dnsforwardingrulesets.DnsForwardingRulesetPatch{}
+			if metadata.ResourceData.HasChange("private_dns_resolver_outbound_endpoint_ids") {
+				outboundEndpoints := expandDnsForwardingRulesetOutboundEndpoints(state.PrivateDNSResolverOutboundEndpointIds)
+				patch.DnsResolverOutboundEndpoints = &outboundEndpoints
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				patch.Tags = &state.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, patch, dnsforwardingrulesets.DefaultUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id, dnsforwardingrulesets.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDnsForwardingRulesetOutboundEndpoints(input []string) []dnsforwardingrulesets.SubResource {
+	results := make([]dnsforwardingrulesets.SubResource, 0)
+	for _, item := range input {
+		results = append(results, dnsforwardingrulesets.SubResource{
+			Id: item,
+		})
+	}
+
+	return results
+}
+
+func flattenDnsForwardingRulesetOutboundEndpoints(input []dnsforwardingrulesets.SubResource) []string {
+	results := make([]string, 0)
+	for _, item := range input {
+		results = append(results, item.Id)
+	}
+
+	return results
+}
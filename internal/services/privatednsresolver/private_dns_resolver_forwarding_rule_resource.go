@@ -0,0 +1,306 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateDNSResolverForwardingRuleResource{}
+
+type PrivateDNSResolverForwardingRuleResource struct{}
+
+type PrivateDNSResolverForwardingRuleModel struct {
+	Name                   string                                   `tfschema:"name"`
+	DnsForwardingRulesetId string                                   `tfschema:"dns_forwarding_ruleset_id"`
+	DomainName             string                                   `tfschema:"domain_name"`
+	Enabled                bool                                     `tfschema:"enabled"`
+	Metadata               map[string]string                        `tfschema:"metadata"`
+	TargetDnsServers       []PrivateDNSResolverTargetDnsServerModel `tfschema:"target_dns_servers"`
+}
+
+type PrivateDNSResolverTargetDnsServerModel struct {
+	IPAddress string `tfschema:"ip_address"`
+	Port      int64  `tfschema:"port"`
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_forwarding_rule"
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) ModelObject() interface{} {
+	return &PrivateDNSResolverForwardingRuleModel{}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return forwardingrules.ValidateForwardingRuleID
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: forwardingrules.ValidateDnsForwardingRulesetID,
+		},
+
+		"domain_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateForwardingRuleDomainName,
+		},
+
+		"target_dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      53,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			var state PrivateDNSResolverForwardingRuleModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			rulesetId, err := forwardingrules.ParseDnsForwardingRulesetID(state.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+
+			id := forwardingrules.NewForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroupName, rulesetId.DnsForwardingRulesetName, state.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			ruleState := forwardingrules.ForwardingRuleStateDisabled
+			if state.Enabled {
+				ruleState = forwardingrules.ForwardingRuleStateEnabled
+			}
+
+			input := forwardingrules.ForwardingRule{
+				Properties: forwardingrules.ForwardingRuleProperties{
+					DomainName:          state.DomainName,
+					ForwardingRuleState: &ruleState,
+					Metadata:            &state.Metadata,
+					TargetDnsServers:    expandPrivateDNSResolverTargetDnsServers(state.TargetDnsServers),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, input, forwardingrules.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := forwardingrules.ParseForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDNSResolverForwardingRuleModel{
+				Name:                   id.ForwardingRuleName,
+				DnsForwardingRulesetId: forwardingrules.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.DomainName = props.DomainName
+				state.Enabled = props.ForwardingRuleState != nil && *props.ForwardingRuleState == forwardingrules.ForwardingRuleStateEnabled
+				state.TargetDnsServers = flattenPrivateDNSResolverTargetDnsServers(props.TargetDnsServers)
+				if props.Metadata != nil {
+					state.Metadata = *props.Metadata
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := forwardingrules.ParseForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state PrivateDNSResolverForwardingRuleModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := forwardingrules.ForwardingRulePatchProperties{}
+			if metadata.ResourceData.HasChange("enabled") {
+				ruleState := forwardingrules.ForwardingRuleStateDisabled
+				if state.Enabled {
+					ruleState = forwardingrules.ForwardingRuleStateEnabled
+				}
+				props.ForwardingRuleState = &ruleState
+			}
+			if metadata.ResourceData.HasChange("metadata") {
+				props.Metadata = &state.Metadata
+			}
+			if metadata.ResourceData.HasChange("target_dns_servers") {
+				targetDnsServers := expandPrivateDNSResolverTargetDnsServers(state.TargetDnsServers)
+				props.TargetDnsServers = &targetDnsServers
+			}
+
+			patch := forwardingrules.ForwardingRulePatch{
+				Properties: &props,
+			}
+			if _, err := client.Update(ctx, *id, patch, forwardingrules.DefaultUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := forwardingrules.ParseForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if _, err := client.Delete(ctx, *id, forwardingrules.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDNSResolverTargetDnsServers(input []PrivateDNSResolverTargetDnsServerModel) []forwardingrules.TargetDnsServer {
+	results := make([]forwardingrules.TargetDnsServer, 0)
+	for _, item := range input {
+		results = append(results, forwardingrules.TargetDnsServer{
+			IPAddress: item.IPAddress,
+			Port:      utils.Int64(item.Port),
+		})
+	}
+
+	return results
+}
+
+func flattenPrivateDNSResolverTargetDnsServers(input []forwardingrules.TargetDnsServer) []PrivateDNSResolverTargetDnsServerModel {
+	results := make([]PrivateDNSResolverTargetDnsServerModel, 0)
+	for _, item := range input {
+		targetDnsServer := PrivateDNSResolverTargetDnsServerModel{
+			IPAddress: item.IPAddress,
+		}
+		if item.Port != nil {
+			targetDnsServer.Port = *item.Port
+		}
+
+		results = append(results, targetDnsServer)
+	}
+
+	return results
+}
+
+// validateForwardingRuleDomainName checks the Domain Name is fully qualified, which the API requires it to be
+func validateForwardingRuleDomainName(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if len(v) < 2 || !strings.HasSuffix(v, ".") {
+		errors = append(errors, fmt.Errorf("%q must be a fully qualified domain name ending with a `.`, got %q", key, v))
+	}
+
+	return
+}
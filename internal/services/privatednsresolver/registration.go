@@ -0,0 +1,45 @@
+package privatednsresolver
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+type Registration struct{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/dns"
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Private DNS Resolver"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Private DNS Resolver",
+	}
+}
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		PrivateDNSResolverDataSource{},
+		PrivateDNSResolverDnsForwardingRulesetDataSource{},
+	}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		PrivateDNSResolverResource{},
+		PrivateDNSResolverInboundEndpointResource{},
+		PrivateDNSResolverOutboundEndpointResource{},
+		PrivateDNSResolverDnsForwardingRulesetResource{},
+		PrivateDNSResolverForwardingRuleResource{},
+		PrivateDNSResolverVirtualNetworkLinkResource{},
+	}
+}
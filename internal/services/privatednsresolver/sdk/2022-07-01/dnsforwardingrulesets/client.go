@@ -0,0 +1,15 @@
+package dnsforwardingrulesets
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DnsForwardingRulesetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDnsForwardingRulesetsClientWithBaseURI(endpoint string) DnsForwardingRulesetsClient {
+	return DnsForwardingRulesetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
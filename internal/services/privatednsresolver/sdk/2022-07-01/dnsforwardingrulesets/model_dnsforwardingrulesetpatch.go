@@ -0,0 +1,6 @@
+package dnsforwardingrulesets
+
+type DnsForwardingRulesetPatch struct {
+	DnsResolverOutboundEndpoints *[]SubResource     `json:"dnsResolverOutboundEndpoints,omitempty"`
+	Tags                         *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,15 @@
+package dnsresolvers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DnsResolversClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDnsResolversClientWithBaseURI(endpoint string) DnsResolversClient {
+	return DnsResolversClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
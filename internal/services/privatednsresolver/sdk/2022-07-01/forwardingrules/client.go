@@ -0,0 +1,15 @@
+package forwardingrules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ForwardingRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewForwardingRulesClientWithBaseURI(endpoint string) ForwardingRulesClient {
+	return ForwardingRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,7 @@
+package forwardingrules
+
+type ForwardingRulePatchProperties struct {
+	ForwardingRuleState *ForwardingRuleState `json:"forwardingRuleState,omitempty"`
+	Metadata            *map[string]string   `json:"metadata,omitempty"`
+	TargetDnsServers    *[]TargetDnsServer   `json:"targetDnsServers,omitempty"`
+}
@@ -0,0 +1,5 @@
+package inboundendpoints
+
+type InboundEndpointPatch struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
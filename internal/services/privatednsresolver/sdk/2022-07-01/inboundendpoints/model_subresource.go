@@ -0,0 +1,5 @@
+package inboundendpoints
+
+type SubResource struct {
+	Id string `json:"id"`
+}
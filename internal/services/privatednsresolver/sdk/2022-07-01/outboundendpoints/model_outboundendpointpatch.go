@@ -0,0 +1,5 @@
+package outboundendpoints
+
+type OutboundEndpointPatch struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
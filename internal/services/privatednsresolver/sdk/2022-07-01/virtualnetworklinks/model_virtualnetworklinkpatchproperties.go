@@ -0,0 +1,5 @@
+package virtualnetworklinks
+
+type VirtualNetworkLinkPatchProperties struct {
+	Metadata *map[string]string `json:"metadata,omitempty"`
+}
@@ -77,7 +77,25 @@ func resourceRecoveryServicesBackupProtectedVMCreateUpdate(d *pluginsdk.Resource
 		}
 
 		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_backup_protected_vm", *existing.ID)
+			isSoftDeleted := false
+			if properties := existing.Properties; properties != nil {
+				if vm, ok := properties.AsAzureIaaSComputeVMProtectedItem(); ok && vm.IsScheduledForDeferredDelete != nil {
+					isSoftDeleted = *vm.IsScheduledForDeferredDelete
+				}
+			}
+
+			if !isSoftDeleted {
+				return tf.ImportAsExistsError("azurerm_backup_protected_vm", *existing.ID)
+			}
+
+			if !meta.(*clients.Client).Features.RecoveryServicesVault.RecoverSoftDeletedBackupProtectedVM {
+				// this exists but the users opted out, so they must import this it out-of-band
+				return fmt.Errorf(optedOutOfRecoveringSoftDeletedBackupProtectedVMErrorFmt(protectedItemName, vaultName))
+			}
+
+			if err := resourceRecoveryServicesBackupProtectedVMSoftDeletedRecover(ctx, client, vaultName, resourceGroup, containerName, protectedItemName, d); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -227,6 +245,69 @@ func resourceRecoveryServicesBackupProtectedVMWaitForDeletion(ctx context.Contex
 	return resp.(backup.ProtectedItemResource), nil
 }
 
+func resourceRecoveryServicesBackupProtectedVMSoftDeletedRecover(ctx context.Context, client *backup.ProtectedItemsClient, vaultName, resourceGroup, containerName, protectedItemName string, d *pluginsdk.ResourceData) error {
+	log.Printf("[DEBUG] Recovering the Soft-Deleted Azure Backup Protected VM %q (Resource Group %q)", protectedItemName, resourceGroup)
+
+	// rehydrating the item moves it from the soft-deleted state into the paused state, after which it can be updated as usual
+	item := backup.ProtectedItemResource{
+		Properties: &backup.AzureIaaSComputeVMProtectedItem{
+			ProtectedItemType: backup.ProtectedItemTypeMicrosoftClassicComputevirtualMachines,
+			WorkloadType:      backup.DataSourceTypeVM,
+			IsRehydrate:       utils.Bool(true),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item); err != nil {
+		return fmt.Errorf("recovering the Soft-Deleted Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+	}
+
+	state := &pluginsdk.StateChangeConf{
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Pending:    []string{"SoftDeleted"},
+		Target:     []string{"Recovered"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+			if err != nil {
+				return resp, "Error", fmt.Errorf("making Read request on Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+			}
+
+			if properties := resp.Properties; properties != nil {
+				if vm, ok := properties.AsAzureIaaSComputeVMProtectedItem(); ok && vm.IsScheduledForDeferredDelete != nil && *vm.IsScheduledForDeferredDelete {
+					return resp, "SoftDeleted", nil
+				}
+			}
+
+			return resp, "Recovered", nil
+		},
+		Timeout: d.Timeout(pluginsdk.TimeoutCreate),
+	}
+
+	if _, err := state.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Soft-Deleted Azure Backup Protected VM %q (Resource Group %q) to be recovered: %+v", protectedItemName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func optedOutOfRecoveringSoftDeletedBackupProtectedVMErrorFmt(protectedItemName, vaultName string) string {
+	message := `
+An existing soft-deleted Backup Protected VM exists with the Name %q in the Recovery Services
+Vault %q, however automatically recovering this Backup Protected VM has been disabled via the
+"features" block.
+
+Terraform can automatically recover the soft-deleted Backup Protected VM when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different Recovery Services Vault.
+`
+	return fmt.Sprintf(message, protectedItemName, vaultName)
+}
+
 func resourceRecoveryServicesBackupProtectedVMRefreshFunc(ctx context.Context, client *backup.ProtectedItemsClient, vaultName, resourceGroup, containerName, protectedItemName string, policyId string, newResource bool) pluginsdk.StateRefreshFunc {
 	// TODO: split this into two functions
 	return func() (interface{}, string, error) {
@@ -33,6 +33,8 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(managementGroupTemplateDeploymentResourceCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -97,13 +99,27 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if_enabled": templateDeploymentWhatIfEnabledSchema(),
+
 			// Computed
+			"what_if_result": templateDeploymentWhatIfResultSchema(),
+
 			"output_content": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"string_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeString),
+
+			"bool_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeBool),
+
+			"int_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeInt),
+
+			"array_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeString),
+
+			"object_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeString),
 		},
 	}
 }
@@ -317,6 +333,10 @@ func managementGroupTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, me
 		}
 		d.Set("output_content", flattenedOutputs)
 
+		if err := setTemplateDeploymentOutputs(d, props.Outputs); err != nil {
+			return fmt.Errorf("flattening outputs: %+v", err)
+		}
+
 		templateLinkId := ""
 		if props.TemplateLink != nil {
 			if props.TemplateLink.ID != nil {
@@ -363,6 +383,35 @@ func managementGroupTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+func managementGroupTemplateDeploymentResourceCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+
+	return templateDeploymentWhatIfCustomizeDiff(ctx, d, func(ctx context.Context, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+		managementGroupId, err := mgParse.ManagementGroupID(d.Get("management_group_id").(string))
+		if err != nil {
+			return nil, err
+		}
+		id := parse.NewManagementGroupTemplateDeploymentID(managementGroupId.Name, d.Get("name").(string))
+
+		future, err := client.WhatIfAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName, resources.ScopedDeploymentWhatIf{
+			Location:   utils.String(location.Normalize(d.Get("location").(string))),
+			Properties: &properties,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("requesting What-If for %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return nil, fmt.Errorf("waiting for What-If for %s: %+v", id, err)
+		}
+		result, err := future.Result(*client)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving What-If result for %s: %+v", id, err)
+		}
+
+		return checkTemplateDeploymentWhatIfResult(result)
+	}, "location", "management_group_id")
+}
+
 func validateManagementGroupTemplateDeployment(ctx context.Context, id parse.ManagementGroupTemplateDeploymentId, deployment resources.ScopedDeployment, client *resources.DeploymentsClient) error {
 	validationFuture, err := client.ValidateAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName, deployment)
 	if err != nil {
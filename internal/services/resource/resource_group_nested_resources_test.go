@@ -0,0 +1,90 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestFormatNestedResourceIds(t *testing.T) {
+	t.Cleanup(pluginsdk.RegisterResourceTypesForIdLookup(map[string]*pluginsdk.Resource{
+		"azurerm_nested_test_server": {
+			Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+				split := strings.Split(strings.TrimPrefix(id, "/"), "/")
+				if len(split) != 8 || split[6] != "servers" {
+					return fmt.Errorf("expected a server ID but got %q", id)
+				}
+				return nil
+			}),
+		},
+	}))
+
+	prefix := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers"
+	testData := []struct {
+		Name     string
+		Input    []string
+		Expected []string
+	}{
+		{
+			Name:     "None",
+			Input:    []string{},
+			Expected: []string{},
+		},
+		{
+			Name: "Sorted Case-Insensitively",
+			Input: []string{
+				prefix + "/Microsoft.NestedTest/servers/server2",
+				prefix + "/Microsoft.NestedTest/servers/Server1",
+			},
+			Expected: []string{
+				"* `" + prefix + "/Microsoft.NestedTest/servers/Server1` (`azurerm_nested_test_server`)",
+				"* `" + prefix + "/Microsoft.NestedTest/servers/server2` (`azurerm_nested_test_server`)",
+			},
+		},
+		{
+			Name: "Unknown Resource Type",
+			Input: []string{
+				prefix + "/Microsoft.Unknown/others/other1",
+			},
+			Expected: []string{
+				"* `" + prefix + "/Microsoft.Unknown/others/other1` (an unknown Resource Type)",
+			},
+		},
+		{
+			Name: "Nested Beneath The Closest Parent",
+			Input: []string{
+				prefix + "/Microsoft.NestedTest/servers/server1/databases/database1/schemas/schema1",
+				prefix + "/Microsoft.NestedTest/servers/server1/databases/database1",
+				prefix + "/Microsoft.NestedTest/servers/server1",
+				prefix + "/Microsoft.NestedTest/servers/server10",
+			},
+			Expected: []string{
+				"* `" + prefix + "/Microsoft.NestedTest/servers/server1` (`azurerm_nested_test_server`)",
+				"  * `" + prefix + "/Microsoft.NestedTest/servers/server1/databases/database1` (an unknown Resource Type)",
+				"    * `" + prefix + "/Microsoft.NestedTest/servers/server1/databases/database1/schemas/schema1` (an unknown Resource Type)",
+				"* `" + prefix + "/Microsoft.NestedTest/servers/server10` (`azurerm_nested_test_server`)",
+			},
+		},
+		{
+			Name: "Parent Outside Of The Resource Group",
+			Input: []string{
+				prefix + "/Microsoft.NestedTest/servers/server1/databases/database1",
+			},
+			Expected: []string{
+				"* `" + prefix + "/Microsoft.NestedTest/servers/server1/databases/database1` (an unknown Resource Type)",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := formatNestedResourceIds(v.Input)
+		expected := strings.Join(v.Expected, "\n")
+		if actual != expected {
+			t.Fatalf("Expected:\n%s\n\nbut got:\n%s", expected, actual)
+		}
+	}
+}
@@ -130,17 +130,36 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	// conditionally check for nested resources and error if they exist
 	if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
 		resourceClient := meta.(*clients.Client).Resource.ResourcesClient
-		// Resource groups sometimes hold on to resource information after the resources have been deleted. We'll retry this check to account for that eventual consistency.
-		err = pluginsdk.Retry(2*time.Minute, func() *pluginsdk.RetryError {
+
+		// Resource groups sometimes hold on to resource information after the resources have been deleted. We'll retry this
+		// check for a short period to account for that eventual consistency. Resources which are being deleted continue to
+		// be listed until their deletion completes - when opted in we'll wait for as long as the timeout allows whilst the
+		// remaining Resources are all being deleted.
+		waitForDeletingResources := meta.(*clients.Client).Features.ResourceGroup.WaitForDeletingResources
+		retryTimeout := 2 * time.Minute
+		if waitForDeletingResources {
+			timeout, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+			retryTimeout = time.Until(timeout)
+		}
+		eventualConsistencyDeadline := time.Now().Add(2 * time.Minute)
+		err = pluginsdk.Retry(retryTimeout, func() *pluginsdk.RetryError {
 			results, err := resourceClient.ListByResourceGroupComplete(ctx, id.ResourceGroup, "", "provisioningState", utils.Int32(500))
 			if err != nil {
 				return pluginsdk.NonRetryableError(fmt.Errorf("listing resources in %s: %v", *id, err))
 			}
 			nestedResourceIds := make([]string, 0)
+			allBeingDeleted := true
 			for results.NotDone() {
 				val := results.Value()
 				if val.ID != nil {
 					nestedResourceIds = append(nestedResourceIds, *val.ID)
+
+					if val.ProvisioningState == nil || !strings.EqualFold(*val.ProvisioningState, "Deleting") {
+						allBeingDeleted = false
+					}
 				}
 
 				if err := results.NextWithContext(ctx); err != nil {
@@ -149,8 +168,14 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 			}
 
 			if len(nestedResourceIds) > 0 {
+				err := resourceGroupContainsItemsError(id.ResourceGroup, nestedResourceIds)
+				if !(waitForDeletingResources && allBeingDeleted) && time.Now().After(eventualConsistencyDeadline) {
+					return pluginsdk.NonRetryableError(err)
+				}
+
+				log.Printf("[DEBUG] %d Resources still exist within %s - waiting for these to be removed..", len(nestedResourceIds), *id)
 				time.Sleep(30 * time.Second)
-				return pluginsdk.RetryableError(resourceGroupContainsItemsError(id.ResourceGroup, nestedResourceIds))
+				return pluginsdk.RetryableError(err)
 			}
 			return nil
 		})
@@ -173,18 +198,15 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 }
 
 func resourceGroupContainsItemsError(name string, nestedResourceIds []string) error {
-	formattedResourceUris := make([]string, 0)
-	for _, id := range nestedResourceIds {
-		formattedResourceUris = append(formattedResourceUris, fmt.Sprintf("* `%s`", id))
-	}
-	sort.Strings(formattedResourceUris)
+	formattedResourceUris := formatNestedResourceIds(nestedResourceIds)
 
 	message := fmt.Sprintf(`deleting Resource Group %[1]q: the Resource Group still contains Resources.
 
 Terraform is configured to check for Resources within the Resource Group when deleting the Resource Group - and
 raise an error if nested Resources still exist to avoid unintentionally deleting these Resources.
 
-Terraform has detected that the following Resources still exist within the Resource Group:
+Terraform has detected that the following Resources still exist within the Resource Group (along with the
+Terraform Resource Type(s) which can be used to manage each of them, where known):
 
 %[2]s
 
@@ -206,6 +228,56 @@ delete this using the Azure API directly (which will clear up any nested resourc
 
 More information on the 'features' block can be found in the documentation:
 https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
-`, name, strings.Join(formattedResourceUris, "\n"))
+`, name, formattedResourceUris)
 	return fmt.Errorf(strings.ReplaceAll(message, "'", "`"))
 }
+
+// formatNestedResourceIds returns a sorted list of the specified Resource IDs, including the Terraform Resource Type(s)
+// which can be used to manage each Resource - with any child Resources indented beneath their parent Resource.
+func formatNestedResourceIds(nestedResourceIds []string) string {
+	ids := make([]string, len(nestedResourceIds))
+	copy(ids, nestedResourceIds)
+	sort.Slice(ids, func(i, j int) bool {
+		return strings.ToLower(ids[i]) < strings.ToLower(ids[j])
+	})
+
+	// find the closest parent for each Resource ID, where one exists within the Resource Group
+	children := make(map[string][]string)
+	topLevel := make([]string, 0)
+	for _, id := range ids {
+		parent := ""
+		for _, other := range ids {
+			if other == id || len(other) <= len(parent) {
+				continue
+			}
+			if strings.HasPrefix(strings.ToLower(id), strings.ToLower(other)+"/") {
+				parent = other
+			}
+		}
+
+		if parent == "" {
+			topLevel = append(topLevel, id)
+			continue
+		}
+		children[parent] = append(children[parent], id)
+	}
+
+	lines := make([]string, 0)
+	var appendLines func(id string, depth int)
+	appendLines = func(id string, depth int) {
+		resourceTypes := "an unknown Resource Type"
+		if v := pluginsdk.ResourceTypesForId(id); len(v) > 0 {
+			resourceTypes = fmt.Sprintf("`%s`", strings.Join(v, "` / `"))
+		}
+		lines = append(lines, fmt.Sprintf("%s* `%s` (%s)", strings.Repeat("  ", depth), id, resourceTypes))
+
+		for _, child := range children[id] {
+			appendLines(child, depth+1)
+		}
+	}
+	for _, id := range topLevel {
+		appendLines(id, 0)
+	}
+
+	return strings.Join(lines, "\n")
+}
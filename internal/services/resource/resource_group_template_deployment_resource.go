@@ -30,6 +30,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceGroupTemplateDeploymentResourceCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -96,13 +98,27 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if_enabled": templateDeploymentWhatIfEnabledSchema(),
+
 			// Computed
+			"what_if_result": templateDeploymentWhatIfResultSchema(),
+
 			"output_content": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"string_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeString),
+
+			"bool_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeBool),
+
+			"int_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeInt),
+
+			"array_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeString),
+
+			"object_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeString),
 		},
 	}
 }
@@ -311,6 +327,10 @@ func resourceGroupTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, meta
 		}
 		d.Set("output_content", flattenedOutputs)
 
+		if err := setTemplateDeploymentOutputs(d, props.Outputs); err != nil {
+			return fmt.Errorf("flattening outputs: %+v", err)
+		}
+
 		templateLinkId := ""
 		if props.TemplateLink != nil {
 			if props.TemplateLink.ID != nil {
@@ -379,6 +399,43 @@ func resourceGroupTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData, me
 	return nil
 }
 
+func resourceGroupTemplateDeploymentResourceCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	groupsClient := meta.(*clients.Client).Resource.GroupsClient
+
+	return templateDeploymentWhatIfCustomizeDiff(ctx, d, func(ctx context.Context, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+		id := parse.NewResourceGroupTemplateDeploymentID(meta.(*clients.Client).Account.SubscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+		// the Resource Group may be created within the same apply, in which case the result can't be determined yet
+		group, err := groupsClient.Get(ctx, id.ResourceGroup)
+		if err != nil {
+			if utils.ResponseWasNotFound(group.Response) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("retrieving Resource Group %q: %+v", id.ResourceGroup, err)
+		}
+
+		// only Resource Group Template Deployments support Complete mode
+		properties.Mode = resources.DeploymentMode(d.Get("deployment_mode").(string))
+
+		future, err := client.WhatIf(ctx, id.ResourceGroup, id.DeploymentName, resources.DeploymentWhatIf{
+			Properties: &properties,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("requesting What-If for %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return nil, fmt.Errorf("waiting for What-If for %s: %+v", id, err)
+		}
+		result, err := future.Result(*client)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving What-If result for %s: %+v", id, err)
+		}
+
+		return checkTemplateDeploymentWhatIfResult(result)
+	}, "deployment_mode", "resource_group_name")
+}
+
 func validateResourceGroupTemplateDeployment(ctx context.Context, id parse.ResourceGroupTemplateDeploymentId, deployment resources.Deployment, client *resources.DeploymentsClient) error {
 	validationFuture, err := client.Validate(ctx, id.ResourceGroup, id.DeploymentName, deployment)
 	if err != nil {
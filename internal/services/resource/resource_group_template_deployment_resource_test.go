@@ -62,6 +62,31 @@ func TestAccResourceGroupTemplateDeployment_incremental(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupTemplateDeployment_whatIf(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
+	r := ResourceGroupTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.whatIfConfig(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("what_if_enabled", "what_if_result"),
+		{
+			Config: r.whatIfConfig(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("what_if_result.0.create_count").HasValue("0"),
+				check.That(data.ResourceName).Key("what_if_result.0.modify_count").HasValue("1"),
+				check.That(data.ResourceName).Key("what_if_result.0.delete_count").HasValue("0"),
+			),
+		},
+		data.ImportStep("what_if_enabled", "what_if_result"),
+	})
+}
+
 func TestAccResourceGroupTemplateDeployment_singleItemIncorrectCasing(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
 	r := ResourceGroupTemplateDeploymentResource{}
@@ -161,6 +186,26 @@ func TestAccResourceGroupTemplateDeployment_withOutputs(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupTemplateDeployment_withTypedOutputs(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
+	r := ResourceGroupTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withTypedOutputsConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("string_outputs.stringOutput").HasValue("some-value"),
+				check.That(data.ResourceName).Key("int_outputs.intOutput").HasValue("42"),
+				check.That(data.ResourceName).Key("bool_outputs.boolOutput").HasValue("true"),
+				check.That(data.ResourceName).Key("array_outputs.arrayOutput").HasValue("[\"first\",\"second\"]"),
+				check.That(data.ResourceName).Key("object_outputs.objectOutput").HasValue("{\"hello\":\"world\"}"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccResourceGroupTemplateDeployment_multipleItems(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
 	r := ResourceGroupTemplateDeploymentResource{}
@@ -776,6 +821,49 @@ TEMPLATE
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, tagValue)
 }
 
+func (ResourceGroupTemplateDeploymentResource) whatIfConfig(data acceptance.TestData, tagValue string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+  what_if_enabled     = true
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2015-06-15",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      },
+      "tags": {
+        "Hello": %q
+      }
+    }
+  ]
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, tagValue)
+}
+
 func (ResourceGroupTemplateDeploymentResource) withOutputsConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -811,6 +899,62 @@ TEMPLATE
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (ResourceGroupTemplateDeploymentResource) withTypedOutputsConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Complete"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "stringOutput": {
+      "type": "String",
+      "value": "some-value"
+    },
+    "intOutput": {
+      "type": "Int",
+      "value": 42
+    },
+    "boolOutput": {
+      "type": "Bool",
+      "value": true
+    },
+    "arrayOutput": {
+      "type": "Array",
+      "value": [
+        "first",
+        "second"
+      ]
+    },
+    "objectOutput": {
+      "type": "Object",
+      "value": {
+        "hello": "world"
+      }
+    }
+  }
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (ResourceGroupTemplateDeploymentResource) multipleItemsConfig(data acceptance.TestData, value string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
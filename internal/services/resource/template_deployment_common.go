@@ -12,6 +12,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -83,6 +84,116 @@ func flattenTemplateDeploymentBody(input interface{}) (*string, error) {
 	return &output, nil
 }
 
+type templateDeploymentOutputs struct {
+	Strings map[string]string
+	Bools   map[string]bool
+	Ints    map[string]int
+	Arrays  map[string]string
+	Objects map[string]string
+}
+
+// templateDeploymentOutputsSchema returns the schema for the Outputs of a Template Deployment of a single ARM
+// Output Type - since Terraform requires all of the values within a map to be of the same type
+func templateDeploymentOutputsSchema(valueType pluginsdk.ValueType) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: valueType,
+		},
+	}
+}
+
+func setTemplateDeploymentOutputs(d *pluginsdk.ResourceData, input interface{}) error {
+	outputs, err := flattenTemplateDeploymentOutputs(input)
+	if err != nil {
+		return err
+	}
+
+	d.Set("string_outputs", outputs.Strings)
+	d.Set("bool_outputs", outputs.Bools)
+	d.Set("int_outputs", outputs.Ints)
+	d.Set("array_outputs", outputs.Arrays)
+	d.Set("object_outputs", outputs.Objects)
+	return nil
+}
+
+// flattenTemplateDeploymentOutputs groups the Outputs of a Template Deployment by their ARM Output Type. Outputs of the
+// type `array` and `object` are exposed as JSON. ARM doesn't return the values for `securestring` and `secureobject`
+// Outputs, so these are omitted.
+func flattenTemplateDeploymentOutputs(input interface{}) (*templateDeploymentOutputs, error) {
+	outputs := templateDeploymentOutputs{
+		Strings: make(map[string]string),
+		Bools:   make(map[string]bool),
+		Ints:    make(map[string]int),
+		Arrays:  make(map[string]string),
+		Objects: make(map[string]string),
+	}
+
+	outputsRaw, ok := input.(map[string]interface{})
+	if !ok {
+		return &outputs, nil
+	}
+
+	for key, v := range outputsRaw {
+		output, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		outputType, ok := output["type"].(string)
+		if !ok {
+			log.Printf("[DEBUG] Output %q has no type - skipping", key)
+			continue
+		}
+		outputValue, ok := output["value"]
+		if !ok {
+			log.Printf("[DEBUG] Output %q has no value - skipping", key)
+			continue
+		}
+
+		switch strings.ToLower(outputType) {
+		case "string":
+			value, ok := outputValue.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected the value for output %q to be a string but got %T", key, outputValue)
+			}
+			outputs.Strings[key] = value
+
+		case "bool":
+			value, ok := outputValue.(bool)
+			if !ok {
+				return nil, fmt.Errorf("expected the value for output %q to be a bool but got %T", key, outputValue)
+			}
+			outputs.Bools[key] = value
+
+		case "int":
+			// numbers are unmarshalled from the JSON as a float64
+			value, ok := outputValue.(float64)
+			if !ok {
+				return nil, fmt.Errorf("expected the value for output %q to be a number but got %T", key, outputValue)
+			}
+			outputs.Ints[key] = int(value)
+
+		case "array", "object":
+			flattened, err := flattenTemplateDeploymentBody(outputValue)
+			if err != nil {
+				return nil, fmt.Errorf("flattening the value for output %q: %+v", key, err)
+			}
+			if strings.EqualFold(outputType, "array") {
+				outputs.Arrays[key] = *flattened
+			} else {
+				outputs.Objects[key] = *flattened
+			}
+
+		default:
+			log.Printf("[DEBUG] Output %q has the unsupported type %q - skipping", key, outputType)
+		}
+	}
+
+	return &outputs, nil
+}
+
 func filterOutTemplateDeploymentParameters(input interface{}) interface{} {
 	if input == nil {
 		return nil
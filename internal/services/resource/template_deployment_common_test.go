@@ -0,0 +1,108 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenTemplateDeploymentOutputs(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Input       interface{}
+		Expected    *templateDeploymentOutputs
+		ExpectError bool
+	}{
+		{
+			Name:  "Nil",
+			Input: nil,
+			Expected: &templateDeploymentOutputs{
+				Strings: map[string]string{},
+				Bools:   map[string]bool{},
+				Ints:    map[string]int{},
+				Arrays:  map[string]string{},
+				Objects: map[string]string{},
+			},
+		},
+		{
+			Name: "Typed Outputs",
+			Input: map[string]interface{}{
+				"stringOutput": map[string]interface{}{
+					"type":  "String",
+					"value": "some-value",
+				},
+				"boolOutput": map[string]interface{}{
+					"type":  "Bool",
+					"value": true,
+				},
+				"intOutput": map[string]interface{}{
+					"type":  "Int",
+					"value": float64(42),
+				},
+				"arrayOutput": map[string]interface{}{
+					"type":  "Array",
+					"value": []interface{}{"first", float64(2)},
+				},
+				"objectOutput": map[string]interface{}{
+					"type": "Object",
+					"value": map[string]interface{}{
+						"hello": "world",
+					},
+				},
+			},
+			Expected: &templateDeploymentOutputs{
+				Strings: map[string]string{"stringOutput": "some-value"},
+				Bools:   map[string]bool{"boolOutput": true},
+				Ints:    map[string]int{"intOutput": 42},
+				Arrays:  map[string]string{"arrayOutput": `["first",2]`},
+				Objects: map[string]string{"objectOutput": `{"hello":"world"}`},
+			},
+		},
+		{
+			Name: "Secure Outputs without a Value",
+			Input: map[string]interface{}{
+				"secureStringOutput": map[string]interface{}{
+					"type": "SecureString",
+				},
+				"secureObjectOutput": map[string]interface{}{
+					"type": "SecureObject",
+				},
+			},
+			Expected: &templateDeploymentOutputs{
+				Strings: map[string]string{},
+				Bools:   map[string]bool{},
+				Ints:    map[string]int{},
+				Arrays:  map[string]string{},
+				Objects: map[string]string{},
+			},
+		},
+		{
+			Name: "Value not matching the Type",
+			Input: map[string]interface{}{
+				"boolOutput": map[string]interface{}{
+					"type":  "Bool",
+					"value": "true",
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := flattenTemplateDeploymentOutputs(v.Input)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
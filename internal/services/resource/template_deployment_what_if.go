@@ -0,0 +1,220 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// templateDeploymentWhatIfTimeout is the maximum amount of time to wait for a What-If operation during a plan
+const templateDeploymentWhatIfTimeout = 15 * time.Minute
+
+func templateDeploymentWhatIfEnabledSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+func templateDeploymentWhatIfResultSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"create_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"modify_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"delete_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"create_resource_ids": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"modify_resource_ids": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"delete_resource_ids": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// templateDeploymentWhatIfRunFunc runs the What-If operation for the specified deployment properties at the relevant scope,
+// returning a nil result when this can't be determined until apply-time (for example as the Resource Group doesn't exist yet)
+type templateDeploymentWhatIfRunFunc func(ctx context.Context, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error)
+
+// templateDeploymentWhatIfCustomizeDiff runs a What-If operation for the planned Template Deployment when `what_if_enabled`
+// is set, exposing a summary of the changes which would be made as `what_if_result` within the plan. Changes to any of the
+// `additionalFields` (which are specific to the scope of the Template Deployment) also cause the What-If operation to run.
+func templateDeploymentWhatIfCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, whatIf templateDeploymentWhatIfRunFunc, additionalFields ...string) error {
+	if !d.Get("what_if_enabled").(bool) {
+		// clear any result from a previous plan, since this would otherwise remain in the state
+		if d.Id() != "" && len(d.Get("what_if_result").([]interface{})) > 0 {
+			return d.SetNew("what_if_result", []interface{}{})
+		}
+		return nil
+	}
+
+	// only run the What-If operation when the Template Deployment is going to be (re)deployed - otherwise the result
+	// would differ from the result stored in the state once deployed, resulting in a perpetual diff
+	fieldsTriggeringDeployment := append([]string{
+		"debug_level",
+		"parameters_content",
+		"template_content",
+		"template_spec_version_id",
+		"what_if_enabled",
+	}, additionalFields...)
+	if d.Id() != "" {
+		hasChanges := false
+		for _, field := range fieldsTriggeringDeployment {
+			if d.HasChange(field) {
+				hasChanges = true
+				break
+			}
+		}
+		if !hasChanges {
+			return nil
+		}
+	}
+
+	// the result can't be determined when the Template/Parameters/Scope aren't known until apply-time
+	for _, field := range append([]string{"template_content", "template_spec_version_id", "parameters_content"}, additionalFields...) {
+		if !d.NewValueKnown(field) {
+			return d.SetNewComputed("what_if_result")
+		}
+	}
+
+	properties, err := expandTemplateDeploymentWhatIfProperties(d)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, templateDeploymentWhatIfTimeout)
+	defer cancel()
+
+	result, err := whatIf(ctx, *properties)
+	if err != nil {
+		return fmt.Errorf("running the What-If operation for the Template Deployment (this can be disabled by setting `what_if_enabled` to `false`): %+v", err)
+	}
+	if result == nil {
+		return d.SetNewComputed("what_if_result")
+	}
+
+	// any Resources which would be deleted are listed in `delete_resource_ids`, which is shown in the plan
+	return d.SetNew("what_if_result", flattenTemplateDeploymentWhatIfResult(result))
+}
+
+func expandTemplateDeploymentWhatIfProperties(d *pluginsdk.ResourceDiff) (*resources.DeploymentWhatIfProperties, error) {
+	properties := resources.DeploymentWhatIfProperties{
+		DebugSetting: expandTemplateDeploymentDebugSetting(d.Get("debug_level").(string)),
+		Mode:         resources.DeploymentModeIncremental,
+		WhatIfSettings: &resources.DeploymentWhatIfSettings{
+			ResultFormat: resources.WhatIfResultFormatResourceIDOnly,
+		},
+	}
+
+	// `template_content` is Computed when a Template Spec is used, so the Template Spec takes precedence
+	if v := d.Get("template_spec_version_id").(string); v != "" {
+		properties.TemplateLink = &resources.TemplateLink{
+			ID: utils.String(v),
+		}
+	} else {
+		template, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		properties.Template = template
+	}
+
+	if v := d.Get("parameters_content").(string); v != "" {
+		parameters, err := expandTemplateDeploymentBody(v)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	return &properties, nil
+}
+
+func flattenTemplateDeploymentWhatIfResult(input *resources.WhatIfOperationResult) []interface{} {
+	createIds := make([]string, 0)
+	modifyIds := make([]string, 0)
+	deleteIds := make([]string, 0)
+
+	if input != nil && input.WhatIfOperationProperties != nil && input.WhatIfOperationProperties.Changes != nil {
+		for _, change := range *input.WhatIfOperationProperties.Changes {
+			resourceId := ""
+			if change.ResourceID != nil {
+				resourceId = *change.ResourceID
+			}
+
+			switch change.ChangeType {
+			case resources.ChangeTypeCreate:
+				createIds = append(createIds, resourceId)
+			case resources.ChangeTypeDeploy, resources.ChangeTypeModify:
+				modifyIds = append(modifyIds, resourceId)
+			case resources.ChangeTypeDelete:
+				deleteIds = append(deleteIds, resourceId)
+			}
+		}
+	}
+
+	sort.Strings(createIds)
+	sort.Strings(modifyIds)
+	sort.Strings(deleteIds)
+
+	return []interface{}{
+		map[string]interface{}{
+			"create_count":        len(createIds),
+			"modify_count":        len(modifyIds),
+			"delete_count":        len(deleteIds),
+			"create_resource_ids": createIds,
+			"modify_resource_ids": modifyIds,
+			"delete_resource_ids": deleteIds,
+		},
+	}
+}
+
+func checkTemplateDeploymentWhatIfResult(input resources.WhatIfOperationResult) (*resources.WhatIfOperationResult, error) {
+	if input.Error != nil {
+		if input.Error.Message != nil {
+			return nil, fmt.Errorf("%s", *input.Error.Message)
+		}
+		return nil, fmt.Errorf("%+v", *input.Error)
+	}
+
+	return &input, nil
+}
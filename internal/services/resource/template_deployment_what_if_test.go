@@ -0,0 +1,112 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenTemplateDeploymentWhatIfResult(t *testing.T) {
+	empty := []interface{}{
+		map[string]interface{}{
+			"create_count":        0,
+			"modify_count":        0,
+			"delete_count":        0,
+			"create_resource_ids": []string{},
+			"modify_resource_ids": []string{},
+			"delete_resource_ids": []string{},
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Input    *resources.WhatIfOperationResult
+		Expected []interface{}
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: empty,
+		},
+		{
+			Name:     "No Properties",
+			Input:    &resources.WhatIfOperationResult{},
+			Expected: empty,
+		},
+		{
+			Name: "No Changes",
+			Input: &resources.WhatIfOperationResult{
+				WhatIfOperationProperties: &resources.WhatIfOperationProperties{
+					Changes: &[]resources.WhatIfChange{},
+				},
+			},
+			Expected: empty,
+		},
+		{
+			Name: "Changes",
+			Input: &resources.WhatIfOperationResult{
+				WhatIfOperationProperties: &resources.WhatIfOperationProperties{
+					Changes: &[]resources.WhatIfChange{
+						{
+							ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2"),
+							ChangeType: resources.ChangeTypeCreate,
+						},
+						{
+							ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"),
+							ChangeType: resources.ChangeTypeCreate,
+						},
+						{
+							ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"),
+							ChangeType: resources.ChangeTypeModify,
+						},
+						{
+							ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/site1"),
+							ChangeType: resources.ChangeTypeDeploy,
+						},
+						{
+							ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/disk1"),
+							ChangeType: resources.ChangeTypeDelete,
+						},
+						{
+							ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/disk2"),
+							ChangeType: resources.ChangeTypeIgnore,
+						},
+						{
+							ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/disk3"),
+							ChangeType: resources.ChangeTypeNoChange,
+						},
+					},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"create_count": 2,
+					"modify_count": 2,
+					"delete_count": 1,
+					"create_resource_ids": []string{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2",
+					},
+					"modify_resource_ids": []string{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Web/sites/site1",
+					},
+					"delete_resource_ids": []string{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/disk1",
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := flattenTemplateDeploymentWhatIfResult(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
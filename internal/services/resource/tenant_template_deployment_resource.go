@@ -31,6 +31,8 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(tenantTemplateDeploymentResourceCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -88,13 +90,27 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if_enabled": templateDeploymentWhatIfEnabledSchema(),
+
 			// Computed
+			"what_if_result": templateDeploymentWhatIfResultSchema(),
+
 			"output_content": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"string_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeString),
+
+			"bool_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeBool),
+
+			"int_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeInt),
+
+			"array_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeString),
+
+			"object_outputs": templateDeploymentOutputsSchema(pluginsdk.TypeString),
 		},
 	}
 }
@@ -301,6 +317,10 @@ func tenantTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, meta interf
 		}
 		d.Set("output_content", flattenedOutputs)
 
+		if err := setTemplateDeploymentOutputs(d, props.Outputs); err != nil {
+			return fmt.Errorf("flattening outputs: %+v", err)
+		}
+
 		templateLinkId := ""
 		if props.TemplateLink != nil {
 			if props.TemplateLink.ID != nil {
@@ -347,6 +367,31 @@ func tenantTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
+func tenantTemplateDeploymentResourceCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+
+	return templateDeploymentWhatIfCustomizeDiff(ctx, d, func(ctx context.Context, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+		id := parse.NewTenantTemplateDeploymentID(d.Get("name").(string))
+
+		future, err := client.WhatIfAtTenantScope(ctx, id.DeploymentName, resources.ScopedDeploymentWhatIf{
+			Location:   utils.String(location.Normalize(d.Get("location").(string))),
+			Properties: &properties,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("requesting What-If for %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return nil, fmt.Errorf("waiting for What-If for %s: %+v", id, err)
+		}
+		result, err := future.Result(*client)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving What-If result for %s: %+v", id, err)
+		}
+
+		return checkTemplateDeploymentWhatIfResult(result)
+	}, "location")
+}
+
 func validateTenantTemplateDeployment(ctx context.Context, id parse.TenantTemplateDeploymentId, deployment resources.ScopedDeployment, client *resources.DeploymentsClient) error {
 	validationFuture, err := client.ValidateAtTenantScope(ctx, id.DeploymentName, deployment)
 	if err != nil {
@@ -40,6 +40,14 @@ type Client struct {
 	SyncGroupsClient            *storagesync.SyncGroupsClient
 	SubscriptionId              string
 
+	// DataPlaneAvailable specifies whether the Storage Data Plane API's can be used, when false the
+	// Containers, Queues, Shares and Tables are managed using the Resource Manager API's instead
+	DataPlaneAvailable bool
+
+	blobContainersClient      *storage.BlobContainersClient
+	fileSharesClient          *storage.FileSharesClient
+	queueClient               *storage.QueueClient
+	tableClient               *storage.TableClient
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
 }
@@ -57,6 +65,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
+	blobContainersClient := storage.NewBlobContainersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobContainersClient.Client, options.ResourceManagerAuthorizer)
+
 	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
 
@@ -72,17 +83,24 @@ func NewClient(options *common.ClientOptions) *Client {
 	fileServicesClient := storage.NewFileServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&fileServicesClient.Client, options.ResourceManagerAuthorizer)
 
+	fileSharesClient := storage.NewFileSharesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&fileSharesClient.Client, options.ResourceManagerAuthorizer)
+
 	objectReplicationPolicyClient := objectreplicationpolicies.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint)
 	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
 
+	queueClient := storage.NewQueueClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&queueClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
 	syncGroupsClient := storagesync.NewSyncGroupsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncGroupsClient.Client, options.ResourceManagerAuthorizer)
 
-	// TODO: switch Storage Containers to using the storage.BlobContainersClient
-	// (which should fix #2977) when the storage clients have been moved in here
+	tableClient := storage.NewTableClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&tableClient.Client, options.ResourceManagerAuthorizer)
+
 	client := Client{
 		AccountsClient:              &accountsClient,
 		FileSystemsClient:           &fileSystemsClient,
@@ -99,6 +117,12 @@ func NewClient(options *common.ClientOptions) *Client {
 		SyncServiceClient:           &syncServiceClient,
 		SyncGroupsClient:            &syncGroupsClient,
 
+		DataPlaneAvailable: options.Features.Storage.DataPlaneAvailable,
+
+		blobContainersClient:      &blobContainersClient,
+		fileSharesClient:          &fileSharesClient,
+		queueClient:               &queueClient,
+		tableClient:               &tableClient,
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
 	}
 
@@ -154,6 +178,10 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 }
 
 func (client Client) ContainersClient(ctx context.Context, account accountDetails) (shim.StorageContainerWrapper, error) {
+	if !client.DataPlaneAvailable {
+		return shim.NewResourceManagerStorageContainerWrapper(client.blobContainersClient), nil
+	}
+
 	if client.storageAdAuth != nil {
 		containersClient := containers.NewWithEnvironment(client.Environment)
 		containersClient.Client.Authorizer = *client.storageAdAuth
@@ -215,6 +243,10 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 }
 
 func (client Client) FileSharesClient(ctx context.Context, account accountDetails) (shim.StorageShareWrapper, error) {
+	if !client.DataPlaneAvailable {
+		return shim.NewResourceManagerStorageShareWrapper(client.fileSharesClient), nil
+	}
+
 	// NOTE: Files do not support AzureAD Authentication
 
 	accountKey, err := account.AccountKey(ctx, client)
@@ -234,6 +266,10 @@ func (client Client) FileSharesClient(ctx context.Context, account accountDetail
 }
 
 func (client Client) QueuesClient(ctx context.Context, account accountDetails) (shim.StorageQueuesWrapper, error) {
+	if !client.DataPlaneAvailable {
+		return shim.NewResourceManagerStorageQueueWrapper(client.queueClient), nil
+	}
+
 	if client.storageAdAuth != nil {
 		queueClient := queues.NewWithEnvironment(client.Environment)
 		queueClient.Client.Authorizer = *client.storageAdAuth
@@ -274,6 +310,10 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 }
 
 func (client Client) TablesClient(ctx context.Context, account accountDetails) (shim.StorageTableWrapper, error) {
+	if !client.DataPlaneAvailable {
+		return shim.NewResourceManagerStorageTableWrapper(client.tableClient), nil
+	}
+
 	// NOTE: Tables do not support AzureAD Authentication
 
 	accountKey, err := account.AccountKey(ctx, client)
@@ -0,0 +1,138 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+type ResourceManagerStorageContainerWrapper struct {
+	client *storage.BlobContainersClient
+}
+
+func NewResourceManagerStorageContainerWrapper(client *storage.BlobContainersClient) StorageContainerWrapper {
+	return ResourceManagerStorageContainerWrapper{
+		client: client,
+	}
+}
+
+func (w ResourceManagerStorageContainerWrapper) Create(ctx context.Context, resourceGroup, accountName, containerName string, input containers.CreateInput) error {
+	container := storage.BlobContainer{
+		ContainerProperties: &storage.ContainerProperties{
+			PublicAccess: w.mapAccessLevel(input.AccessLevel),
+			Metadata:     w.mapMetaData(input.MetaData),
+		},
+	}
+
+	if _, err := w.client.Create(ctx, resourceGroup, accountName, containerName, container); err != nil {
+		return fmt.Errorf("creating container: %+v", err)
+	}
+
+	return nil
+}
+
+func (w ResourceManagerStorageContainerWrapper) Delete(ctx context.Context, resourceGroup, accountName, containerName string) error {
+	resp, err := w.client.Delete(ctx, resourceGroup, accountName, containerName)
+	if utils.ResponseWasNotFound(resp) {
+		return nil
+	}
+
+	return err
+}
+
+func (w ResourceManagerStorageContainerWrapper) Exists(ctx context.Context, resourceGroup, accountName, containerName string) (*bool, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return nil, err
+		}
+	}
+
+	exists := !utils.ResponseWasNotFound(existing.Response)
+	return &exists, nil
+}
+
+func (w ResourceManagerStorageContainerWrapper) Get(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerProperties, error) {
+	container, err := w.client.Get(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(container.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	output := StorageContainerProperties{
+		AccessLevel: containers.Private,
+		MetaData:    map[string]string{},
+	}
+	if props := container.ContainerProperties; props != nil {
+		switch props.PublicAccess {
+		case storage.PublicAccessBlob:
+			output.AccessLevel = containers.Blob
+		case storage.PublicAccessContainer:
+			output.AccessLevel = containers.Container
+		}
+
+		for k, v := range props.Metadata {
+			if v != nil {
+				output.MetaData[k] = *v
+			}
+		}
+
+		if props.HasImmutabilityPolicy != nil {
+			output.HasImmutabilityPolicy = *props.HasImmutabilityPolicy
+		}
+		if props.HasLegalHold != nil {
+			output.HasLegalHold = *props.HasLegalHold
+		}
+	}
+
+	return &output, nil
+}
+
+func (w ResourceManagerStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, resourceGroup, accountName, containerName string, level containers.AccessLevel) error {
+	container := storage.BlobContainer{
+		ContainerProperties: &storage.ContainerProperties{
+			PublicAccess: w.mapAccessLevel(level),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, containerName, container)
+	return err
+}
+
+func (w ResourceManagerStorageContainerWrapper) UpdateMetaData(ctx context.Context, resourceGroup, accountName, containerName string, metaData map[string]string) error {
+	container := storage.BlobContainer{
+		ContainerProperties: &storage.ContainerProperties{
+			Metadata: w.mapMetaData(metaData),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, containerName, container)
+	return err
+}
+
+func (w ResourceManagerStorageContainerWrapper) mapAccessLevel(input containers.AccessLevel) storage.PublicAccess {
+	switch input {
+	case containers.Blob:
+		return storage.PublicAccessBlob
+	case containers.Container:
+		return storage.PublicAccessContainer
+	}
+
+	return storage.PublicAccessNone
+}
+
+func (w ResourceManagerStorageContainerWrapper) mapMetaData(input map[string]string) map[string]*string {
+	output := make(map[string]*string)
+
+	for k, v := range input {
+		output[k] = utils.String(v)
+	}
+
+	return output
+}
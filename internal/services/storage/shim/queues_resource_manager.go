@@ -0,0 +1,104 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/queue/queues"
+)
+
+type ResourceManagerStorageQueueWrapper struct {
+	client *storage.QueueClient
+}
+
+func NewResourceManagerStorageQueueWrapper(client *storage.QueueClient) StorageQueuesWrapper {
+	return ResourceManagerStorageQueueWrapper{
+		client: client,
+	}
+}
+
+func (w ResourceManagerStorageQueueWrapper) Create(ctx context.Context, resourceGroup, accountName, queueName string, metaData map[string]string) error {
+	input := storage.Queue{
+		QueueProperties: &storage.QueueProperties{
+			Metadata: w.mapMetaData(metaData),
+		},
+	}
+	_, err := w.client.Create(ctx, resourceGroup, accountName, queueName, input)
+	return err
+}
+
+func (w ResourceManagerStorageQueueWrapper) Delete(ctx context.Context, resourceGroup, accountName, queueName string) error {
+	resp, err := w.client.Delete(ctx, resourceGroup, accountName, queueName)
+	if utils.ResponseWasNotFound(resp) {
+		return nil
+	}
+
+	return err
+}
+
+func (w ResourceManagerStorageQueueWrapper) Exists(ctx context.Context, resourceGroup, accountName, queueName string) (*bool, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, queueName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, err
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (w ResourceManagerStorageQueueWrapper) Get(ctx context.Context, resourceGroup, accountName, queueName string) (*StorageQueueProperties, error) {
+	queue, err := w.client.Get(ctx, resourceGroup, accountName, queueName)
+	if err != nil {
+		if utils.ResponseWasNotFound(queue.Response) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	metaData := make(map[string]string)
+	if props := queue.QueueProperties; props != nil {
+		for k, v := range props.Metadata {
+			if v != nil {
+				metaData[k] = *v
+			}
+		}
+	}
+
+	return &StorageQueueProperties{
+		MetaData: metaData,
+	}, nil
+}
+
+func (w ResourceManagerStorageQueueWrapper) GetServiceProperties(_ context.Context, _, _ string) (*queues.StorageServiceProperties, error) {
+	// the Resource Manager API only exposes the CORS rules, the logging and metrics require the Data Plane
+	return nil, fmt.Errorf("the Queue Service Properties can only be retrieved using the Data Plane API")
+}
+
+func (w ResourceManagerStorageQueueWrapper) UpdateMetaData(ctx context.Context, resourceGroup, accountName, queueName string, metaData map[string]string) error {
+	input := storage.Queue{
+		QueueProperties: &storage.QueueProperties{
+			Metadata: w.mapMetaData(metaData),
+		},
+	}
+	_, err := w.client.Update(ctx, resourceGroup, accountName, queueName, input)
+	return err
+}
+
+func (w ResourceManagerStorageQueueWrapper) UpdateServiceProperties(_ context.Context, _, _ string, _ queues.StorageServiceProperties) error {
+	// the Resource Manager API only exposes the CORS rules, the logging and metrics require the Data Plane
+	return fmt.Errorf("the Queue Service Properties can only be updated using the Data Plane API")
+}
+
+func (w ResourceManagerStorageQueueWrapper) mapMetaData(input map[string]string) map[string]*string {
+	output := make(map[string]*string)
+
+	for k, v := range input {
+		output[k] = utils.String(v)
+	}
+
+	return output
+}
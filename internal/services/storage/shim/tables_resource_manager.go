@@ -0,0 +1,61 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/table/tables"
+)
+
+type ResourceManagerStorageTableWrapper struct {
+	client *storage.TableClient
+}
+
+func NewResourceManagerStorageTableWrapper(client *storage.TableClient) StorageTableWrapper {
+	return ResourceManagerStorageTableWrapper{
+		client: client,
+	}
+}
+
+func (w ResourceManagerStorageTableWrapper) Create(ctx context.Context, resourceGroup, accountName, tableName string) error {
+	_, err := w.client.Create(ctx, resourceGroup, accountName, tableName)
+	return err
+}
+
+func (w ResourceManagerStorageTableWrapper) Delete(ctx context.Context, resourceGroup, accountName, tableName string) error {
+	resp, err := w.client.Delete(ctx, resourceGroup, accountName, tableName)
+	if utils.ResponseWasNotFound(resp) {
+		return nil
+	}
+
+	return err
+}
+
+func (w ResourceManagerStorageTableWrapper) Exists(ctx context.Context, resourceGroup, accountName, tableName string) (*bool, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, tableName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, err
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (w ResourceManagerStorageTableWrapper) GetACLs(_ context.Context, _, _, _ string) (*[]tables.SignedIdentifier, error) {
+	// the Resource Manager API (2021-04-01) doesn't expose the Stored Access Policies for a Table
+	acls := make([]tables.SignedIdentifier, 0)
+	return &acls, nil
+}
+
+func (w ResourceManagerStorageTableWrapper) UpdateACLs(_ context.Context, _, _, _ string, acls []tables.SignedIdentifier) error {
+	if len(acls) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("the ACL's for a Storage Table can only be configured using the Data Plane API")
+}
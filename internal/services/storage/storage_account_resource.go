@@ -924,6 +924,16 @@ func resourceStorageAccount() *pluginsdk.Resource {
 						return fmt.Errorf("`large_file_share_enabled` cannot be disabled once it's been enabled")
 					}
 				}
+
+				// the Queue Service Properties and Static Website can only be managed using the Data Plane API
+				if !v.(*clients.Client).Storage.DataPlaneAvailable {
+					if _, ok := d.GetOk("queue_properties"); ok {
+						return fmt.Errorf("`queue_properties` cannot be configured when `data_plane_available` is set to `false` within the `storage` features block")
+					}
+					if _, ok := d.GetOk("static_website"); ok {
+						return fmt.Errorf("`static_website` cannot be configured when `data_plane_available` is set to `false` within the `storage` features block")
+					}
+				}
 				return nil
 			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
@@ -1918,7 +1928,10 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("retrieving %s: `sku` was nil", *id)
 	}
 
-	if resp.Sku.Tier == storage.SkuTierStandard {
+	// the Queue Service Properties are only exposed via the Data Plane API
+	if !storageClient.DataPlaneAvailable {
+		d.Set("queue_properties", []interface{}{})
+	} else if resp.Sku.Tier == storage.SkuTierStandard {
 		if resp.Kind == storage.KindStorage || resp.Kind == storage.KindStorageV2 {
 			queueClient, err := storageClient.QueuesClient(ctx, *account)
 			if err != nil {
@@ -1936,6 +1949,12 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	if !storageClient.DataPlaneAvailable {
+		// the Static Website properties are only exposed via the Data Plane API
+		d.Set("static_website", []interface{}{})
+		return tags.FlattenAndSet(d, resp.Tags)
+	}
+
 	var staticWebsite []interface{}
 
 	// static website only supported on StorageV2 and BlockBlobStorage
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,7 +25,7 @@ func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.Resourc
 // ImporterValidatingResourceIdThen validates the ID provided at import time is valid
 // using the validateFunc then runs the 'thenFunc', allowing the import to be customised.
 func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
-	return &schema.ResourceImporter{
+	importer := &schema.ResourceImporter{
 		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
 
@@ -35,4 +36,27 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 			return thenFunc(ctx, d, meta)
 		},
 	}
+
+	importerIdValidators.Store(importer, validateFunc)
+
+	return importer
+}
+
+// importerIdValidators tracks the ID Validation Function used by each Importer, so that
+// the Resource Types which can be used to manage a given Resource ID can be looked up
+var importerIdValidators sync.Map
+
+// IDValidationFuncForImporter returns the ID Validation Function used by the specified
+// Importer, if it was created using ImporterValidatingResourceId/ImporterValidatingResourceIdThen
+func IDValidationFuncForImporter(importer *schema.ResourceImporter) (IDValidationFunc, bool) {
+	if importer == nil {
+		return nil, false
+	}
+
+	v, ok := importerIdValidators.Load(importer)
+	if !ok {
+		return nil, false
+	}
+
+	return v.(IDValidationFunc), true
 }
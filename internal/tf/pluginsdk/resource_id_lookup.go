@@ -0,0 +1,85 @@
+package pluginsdk
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// genericResourceIdCanary is a Resource ID for a Resource Type which doesn't exist, used to
+// exclude ID Validation Functions which accept any Resource ID from the Resource Type lookup
+const genericResourceIdCanary = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Unknown/unknownResources/resource1"
+
+var (
+	resourceTypeIdValidators     = make(map[string]IDValidationFunc)
+	resourceTypeIdValidatorsLock = sync.RWMutex{}
+)
+
+// RegisterResourceTypesForIdLookup registers the ID Validation Functions used by the Importers of
+// the specified Resources, allowing ResourceTypesForId to map a Resource ID to a Resource Type.
+// The returned function restores the registrations of these Resource Types to their previous state,
+// which allows tests to scope their registrations using `t.Cleanup`.
+func RegisterResourceTypesForIdLookup(resources map[string]*Resource) func() {
+	resourceTypeIdValidatorsLock.Lock()
+	defer resourceTypeIdValidatorsLock.Unlock()
+
+	previous := make(map[string]IDValidationFunc)
+	for resourceType, resource := range resources {
+		if resource == nil {
+			continue
+		}
+
+		validateFunc, ok := IDValidationFuncForImporter(resource.Importer)
+		if !ok {
+			continue
+		}
+
+		// some Resources accept any Resource ID, which isn't useful when looking up the Resource Type
+		if err := validateFunc(genericResourceIdCanary); err == nil {
+			continue
+		}
+
+		previous[resourceType] = resourceTypeIdValidators[resourceType]
+		resourceTypeIdValidators[resourceType] = validateFunc
+	}
+
+	return func() {
+		resourceTypeIdValidatorsLock.Lock()
+		defer resourceTypeIdValidatorsLock.Unlock()
+
+		for resourceType, validateFunc := range previous {
+			if validateFunc == nil {
+				delete(resourceTypeIdValidators, resourceType)
+				continue
+			}
+			resourceTypeIdValidators[resourceType] = validateFunc
+		}
+	}
+}
+
+// ResourceTypesForId returns a sorted list of the registered Resource Types which
+// can be used to manage the specified Resource ID
+func ResourceTypesForId(id string) []string {
+	resourceTypeIdValidatorsLock.RLock()
+	defer resourceTypeIdValidatorsLock.RUnlock()
+
+	matches := make([]string, 0)
+	for resourceType, validateFunc := range resourceTypeIdValidators {
+		if err := validateFunc(id); err == nil {
+			matches = append(matches, resourceType)
+		}
+	}
+	sort.Strings(matches)
+
+	// Resources such as `azurerm_subnet_route_table_association` reuse the ID of their parent
+	// Resource, so these are omitted in favour of the parent Resource Type where it's matched
+	resourceTypes := make([]string, 0)
+	for _, resourceType := range matches {
+		if len(resourceTypes) > 0 && strings.HasPrefix(resourceType, resourceTypes[len(resourceTypes)-1]+"_") {
+			continue
+		}
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+
+	return resourceTypes
+}
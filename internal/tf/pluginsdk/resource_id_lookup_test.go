@@ -0,0 +1,92 @@
+package pluginsdk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResourceTypesForId(t *testing.T) {
+	validatorForSegment := func(segment string, parts int) IDValidationFunc {
+		return func(id string) error {
+			split := strings.Split(strings.TrimPrefix(id, "/"), "/")
+			if len(split) != parts || !strings.EqualFold(split[len(split)-2], segment) {
+				return fmt.Errorf("expected a %q ID but got %q", segment, id)
+			}
+			return nil
+		}
+	}
+
+	t.Cleanup(RegisterResourceTypesForIdLookup(map[string]*Resource{
+		"azurerm_lookup_test_network": {
+			Importer: ImporterValidatingResourceId(validatorForSegment("networks", 8)),
+		},
+		"azurerm_lookup_test_network_association": {
+			Importer: ImporterValidatingResourceId(validatorForSegment("networks", 8)),
+		},
+		"azurerm_lookup_test_subnet": {
+			Importer: ImporterValidatingResourceId(validatorForSegment("subnets", 10)),
+		},
+		"azurerm_lookup_test_generic": {
+			Importer: ImporterValidatingResourceId(func(string) error { return nil }),
+		},
+		"azurerm_lookup_test_no_importer": {},
+		"azurerm_lookup_test_nil":         nil,
+	}))
+
+	testData := []struct {
+		Input    string
+		Expected []string
+	}{
+		{
+			// unregistered Resource Types return no matches
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Unknown/others/other1",
+			Expected: []string{},
+		},
+		{
+			// Resource Types which reuse the ID of their parent are omitted in favour of the parent
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.LookupTest/networks/network1",
+			Expected: []string{"azurerm_lookup_test_network"},
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.LookupTest/networks/network1/subnets/subnet1",
+			Expected: []string{"azurerm_lookup_test_subnet"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := ResourceTypesForId(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestRegisterResourceTypesForIdLookupRestore(t *testing.T) {
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.LookupTest/restores/restore1"
+	validateFunc := func(input string) error {
+		if input != id {
+			return fmt.Errorf("expected %q but got %q", id, input)
+		}
+		return nil
+	}
+
+	restore := RegisterResourceTypesForIdLookup(map[string]*Resource{
+		"azurerm_lookup_test_restore": {
+			Importer: ImporterValidatingResourceId(validateFunc),
+		},
+	})
+
+	if actual := ResourceTypesForId(id); !reflect.DeepEqual(actual, []string{"azurerm_lookup_test_restore"}) {
+		t.Fatalf("Expected the Resource Type to be registered but got %+v", actual)
+	}
+
+	restore()
+
+	if actual := ResourceTypesForId(id); len(actual) != 0 {
+		t.Fatalf("Expected the Resource Type to be removed but got %+v", actual)
+	}
+}